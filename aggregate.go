@@ -0,0 +1,54 @@
+package lorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Count returns the number of rows matched by the query, the columns, ORDER BY, LIMIT and OFFSET of the query are ignored,
+// a grouped query counts its groups, see builder.SelectBuilder.ToCountBuilder
+func (s *QueryModelStmt[T]) Count(ctx context.Context) (uint64, error) {
+	countStmt := QueryCol[uint64](s.engine)
+	countStmt.builder = s.builder.ToCountBuilder()
+	count, _, err := countStmt.Get(ctx)
+	return count, err
+}
+
+// CountDistinct returns the number of distinct non NULL values of column in the rows matched by the query
+func (s *QueryModelStmt[T]) CountDistinct(ctx context.Context, column string) (uint64, error) {
+	return aggregate[T, uint64](ctx, s, fmt.Sprintf("COUNT(DISTINCT %s)", column))
+}
+
+// Sum returns the sum of column in the rows matched by the query, eg: Sum[*Order, float64](ctx, Query[*Order](engine).Where(...), "amount"),
+// it returns the zero V if no rows match
+func Sum[T Model, V any](ctx context.Context, s *QueryModelStmt[T], column string) (V, error) {
+	return aggregate[T, V](ctx, s, fmt.Sprintf("SUM(%s)", column))
+}
+
+// Avg returns the average of column in the rows matched by the query, it returns the zero V if no rows match
+func Avg[T Model, V any](ctx context.Context, s *QueryModelStmt[T], column string) (V, error) {
+	return aggregate[T, V](ctx, s, fmt.Sprintf("AVG(%s)", column))
+}
+
+// Min returns the minimum of column in the rows matched by the query, it returns the zero V if no rows match
+func Min[T Model, V any](ctx context.Context, s *QueryModelStmt[T], column string) (V, error) {
+	return aggregate[T, V](ctx, s, fmt.Sprintf("MIN(%s)", column))
+}
+
+// Max returns the maximum of column in the rows matched by the query, it returns the zero V if no rows match
+func Max[T Model, V any](ctx context.Context, s *QueryModelStmt[T], column string) (V, error) {
+	return aggregate[T, V](ctx, s, fmt.Sprintf("MAX(%s)", column))
+}
+
+// aggregate selects expr over the rows matched by s, the NULL result of an empty set is returned as the zero V
+func aggregate[T Model, V any](ctx context.Context, s *QueryModelStmt[T], expr string) (V, error) {
+	stmt := QueryCol[Null[V]](s.engine)
+	stmt.builder = s.builder.ToAggregateBuilder(expr)
+	value, err := stmt.MustGet(ctx)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		var zero V
+		return zero, err
+	}
+	return value.V, nil
+}
@@ -0,0 +1,53 @@
+package lorm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregates(t *testing.T) {
+	engine := newPlanEngine(t, 10)
+	ctx := context.TODO()
+	_, err := engine.Exec(ctx, "UPDATE plan_model SET name = 'odd' WHERE id % 2 = 1")
+	assert.NoError(t, err)
+
+	count, err := Query[*planModel](engine).Where("id > ?", 4).OrderBy("id").Limit(2).Count(ctx)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 6, count)
+	count, err = Query[*planModel](engine).Where("id > ?", 10).Count(ctx)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, count)
+	count, err = Query[*planModel](engine).GroupBy("name").Count(ctx)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 6, count)
+	count, err = Query[*planModel](engine).CountDistinct(ctx, "name")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 6, count)
+
+	sum, err := Sum[*planModel, float64](ctx, Query[*planModel](engine).Where("id <= ?", 4), "score")
+	assert.NoError(t, err)
+	assert.Equal(t, 5.0, sum)
+	avg, err := Avg[*planModel, decimal.Decimal](ctx, Query[*planModel](engine), "id")
+	assert.NoError(t, err)
+	assert.Equal(t, "5.5", avg.String())
+	minID, err := Min[*planModel, int64](ctx, Query[*planModel](engine).Where("name = ?", "odd"), "id")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, minID)
+	maxName, err := Max[*planModel, string](ctx, Query[*planModel](engine), "name")
+	assert.NoError(t, err)
+	assert.Equal(t, "odd", maxName)
+
+	// aggregates of an empty set are NULL
+	sum, err = Sum[*planModel, float64](ctx, Query[*planModel](engine).Where("id > ?", 10), "score")
+	assert.NoError(t, err)
+	assert.Zero(t, sum)
+	maxName, err = Max[*planModel, string](ctx, Query[*planModel](engine).Where("id > ?", 10), "name")
+	assert.NoError(t, err)
+	assert.Empty(t, maxName)
+	nullable, err := Max[*planModel, *int64](ctx, Query[*planModel](engine).Where("id > ?", 10), "id")
+	assert.NoError(t, err)
+	assert.Nil(t, nullable)
+}
@@ -0,0 +1,306 @@
+package lorm
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+
+	"github.com/samber/lo"
+	"github.com/yvvlee/lorm/builder"
+)
+
+// Associate links children to parent through the join table of the many_to_many relation in a transaction,
+// children which are already linked are skipped, eg: Associate(ctx, engine, user, "Roles", admin, editor)
+func Associate[T Table, C Table](ctx context.Context, engine *Engine, parent T, relation string, children ...C) error {
+	join, err := newJoinTable(parent, relation)
+	if err != nil {
+		return err
+	}
+	parentKey, childKeys, err := join.keys(engine, parent, children)
+	if err != nil || len(childKeys) == 0 {
+		return err
+	}
+	return engine.TX(ctx, func(ctx context.Context) error {
+		links, err := join.links(ctx, engine, []any{parentKey})
+		if err != nil {
+			return err
+		}
+		linked := lo.SliceToMap(links, func(link joinLink) (string, bool) {
+			key, _ := relationKey(&link.related)
+			return key, true
+		})
+		return join.insert(ctx, engine, parentKey, lo.Filter(childKeys, func(key any, _ int) bool {
+			k, _ := relationKey(&key)
+			return !linked[k]
+		}))
+	})
+}
+
+// Dissociate removes the links between parent and children from the join table of the many_to_many relation in a transaction
+func Dissociate[T Table, C Table](ctx context.Context, engine *Engine, parent T, relation string, children ...C) error {
+	join, err := newJoinTable(parent, relation)
+	if err != nil {
+		return err
+	}
+	parentKey, childKeys, err := join.keys(engine, parent, children)
+	if err != nil || len(childKeys) == 0 {
+		return err
+	}
+	return engine.TX(ctx, func(ctx context.Context) error {
+		return join.delete(ctx, engine, parentKey, builder.In{Col: engine.Escaper().Escape(join.relation.AssociationKey), Val: childKeys})
+	})
+}
+
+// ReplaceAssociations replaces the links of parent in the join table of the many_to_many relation with children in a transaction,
+// passing no children removes all links of parent
+func ReplaceAssociations[T Table, C Table](ctx context.Context, engine *Engine, parent T, relation string, children ...C) error {
+	join, err := newJoinTable(parent, relation)
+	if err != nil {
+		return err
+	}
+	parentKey, childKeys, err := join.keys(engine, parent, children)
+	if err != nil {
+		return err
+	}
+	return engine.TX(ctx, func(ctx context.Context) error {
+		if err := join.delete(ctx, engine, parentKey, nil); err != nil {
+			return err
+		}
+		return join.insert(ctx, engine, parentKey, childKeys)
+	})
+}
+
+// LoadAssociations loads the relation of parents, it works with every relation kind like Preload
+func LoadAssociations[T Model](ctx context.Context, engine *Engine, relation string, parents ...T) error {
+	return preload(ctx, engine, parents, []string{relation})
+}
+
+// joinTable is the join table of a many_to_many relation
+type joinTable struct {
+	relation *RelationDescriptor
+	// parentKey is the column of the model referenced by ForeignKey
+	parentKey string
+	// relatedKey is the primary key of the related model referenced by AssociationKey
+	relatedKey string
+	related    Table
+}
+
+func newJoinTable(model Model, name string) (*joinTable, error) {
+	descriptor := model.LormModelDescriptor()
+	relation := descriptor.Relation(name)
+	if relation == nil {
+		return nil, fmt.Errorf("lorm: %s has no relation %q", descriptor.Name, name)
+	}
+	if relation.Kind != RelManyToMany {
+		return nil, fmt.Errorf("lorm: %s.%s is not a many_to_many relation", descriptor.Name, name)
+	}
+	_, related, err := relationField(model, relation)
+	if err != nil {
+		return nil, fmt.Errorf("lorm: %s.%s: %w", descriptor.Name, name, err)
+	}
+	join, err := joinTableOf(descriptor, relation, related)
+	if err != nil {
+		return nil, fmt.Errorf("lorm: %s.%s: %w", descriptor.Name, name, err)
+	}
+	return join, nil
+}
+
+func joinTableOf(descriptor *ModelDescriptor, relation *RelationDescriptor, related Table) (*joinTable, error) {
+	if relation.JoinTable == "" || relation.ForeignKey == "" || relation.AssociationKey == "" {
+		return nil, fmt.Errorf("join table, foreign key and association key are required")
+	}
+	parentKey := relation.References
+	if parentKey == "" {
+		var err error
+		if parentKey, err = primaryKey(descriptor); err != nil {
+			return nil, err
+		}
+	}
+	relatedKey, err := primaryKey(related.LormModelDescriptor())
+	if err != nil {
+		return nil, err
+	}
+	return &joinTable{relation: relation, parentKey: parentKey, relatedKey: relatedKey, related: related}, nil
+}
+
+// keys returns the key of parent and the distinct keys of children
+func (j *joinTable) keys(engine *Engine, parent Model, children any) (any, []any, error) {
+	parentKey, err := keyValue(engine, parent, j.parentKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	values := reflect.ValueOf(children)
+	childKeys := make([]any, 0, values.Len())
+	seen := make(map[string]bool, values.Len())
+	for i := 0; i < values.Len(); i++ {
+		key, err := keyValue(engine, values.Index(i).Interface().(Model), j.relatedKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		if k, _ := relationKey(&key); !seen[k] {
+			seen[k] = true
+			childKeys = append(childKeys, key)
+		}
+	}
+	return parentKey, childKeys, nil
+}
+
+// keyValue returns the value of column in model as it is stored in the database, the converter or codec of the field applies,
+// so that the value can be bound and matched with the keys scanned from the join table, NULL values are rejected
+func keyValue(engine *Engine, model Model, column string) (any, error) {
+	descriptor := model.LormModelDescriptor()
+	ptr := model.LormFieldMap()[column]
+	if _, ok := relationKey(ptr); !ok {
+		return nil, fmt.Errorf("lorm: %s of %s is NULL", column, descriptor.Name)
+	}
+	value := engine.wrapField(descriptorFieldMap(descriptor)[column], ptr)
+	valuer, ok := value.(driver.Valuer)
+	if !ok {
+		return reflect.Indirect(reflect.ValueOf(ptr).Elem()).Interface(), nil
+	}
+	v, err := valuer.Value()
+	if err != nil {
+		return nil, fmt.Errorf("lorm: %s of %s: %w", column, descriptor.Name, err)
+	}
+	if v == nil {
+		return nil, fmt.Errorf("lorm: %s of %s is NULL", column, descriptor.Name)
+	}
+	return bytesToString(v), nil
+}
+
+// links queries the rows of the join table linked to parentKeys
+func (j *joinTable) links(ctx context.Context, engine *Engine, parentKeys []any) ([]joinLink, error) {
+	escaper := engine.Escaper()
+	foreignKey := escaper.Escape(j.relation.ForeignKey)
+	query, args, err := builder.Select(foreignKey, escaper.Escape(j.relation.AssociationKey)).
+		From(escaper.Escape(j.relation.JoinTable)).
+		Where(builder.In{Col: foreignKey, Val: parentKeys}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var links []joinLink
+	if err = engine.Query(ctx, &joinLinksScanner{links: &links}, query, args...); err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+func (j *joinTable) insert(ctx context.Context, engine *Engine, parentKey any, childKeys []any) error {
+	if len(childKeys) == 0 {
+		return nil
+	}
+	escaper := engine.Escaper()
+	insertBuilder := builder.Insert(escaper.Escape(j.relation.JoinTable)).
+		Columns(escaper.Escape(j.relation.ForeignKey), escaper.Escape(j.relation.AssociationKey))
+	for _, childKey := range childKeys {
+		insertBuilder.Values(parentKey, childKey)
+	}
+	query, args, err := insertBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = engine.Exec(ctx, query, args...)
+	return err
+}
+
+// delete removes the rows of parentKey from the join table, where narrows the rows if it is not nil
+func (j *joinTable) delete(ctx context.Context, engine *Engine, parentKey any, where builder.Sqlizer) error {
+	escaper := engine.Escaper()
+	deleteBuilder := builder.Delete(escaper.Escape(j.relation.JoinTable)).
+		Where(builder.Eq{escaper.Escape(j.relation.ForeignKey): parentKey})
+	if where != nil {
+		deleteBuilder.Where(where)
+	}
+	query, args, err := deleteBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = engine.Exec(ctx, query, args...)
+	return err
+}
+
+// loadManyToMany queries the related models of parents through the join table and stores them into the relation field
+func loadManyToMany(ctx context.Context, engine *Engine, parents []Model, relation *RelationDescriptor, field reflect.StructField, related Table) error {
+	join, err := joinTableOf(parents[0].LormModelDescriptor(), relation, related)
+	if err != nil {
+		return err
+	}
+	var links []joinLink
+	if keys := relationKeys(parents, join.parentKey); len(keys) > 0 {
+		if links, err = join.links(ctx, engine, keys); err != nil {
+			return err
+		}
+	}
+	var children []Model
+	if len(links) > 0 {
+		relatedKeys := lo.UniqBy(lo.Map(links, func(link joinLink, _ int) any {
+			return link.related
+		}), func(key any) string {
+			k, _ := relationKey(&key)
+			return k
+		})
+		in := builder.In{Col: engine.Escaper().Escape(join.relatedKey), Val: relatedKeys}
+		if children, err = queryRelated(ctx, engine, related, in); err != nil {
+			return err
+		}
+	}
+	childMap := lo.KeyBy(children, func(child Model) string {
+		key, _ := relationKey(child.LormFieldMap()[join.relatedKey])
+		return key
+	})
+	groups := make(map[string][]Model)
+	for _, link := range links {
+		parentKey, _ := relationKey(&link.parent)
+		relatedKey, _ := relationKey(&link.related)
+		if child, ok := childMap[relatedKey]; ok {
+			groups[parentKey] = append(groups[parentKey], child)
+		}
+	}
+	for _, parent := range parents {
+		var matched []Model
+		if key, ok := relationKey(parent.LormFieldMap()[join.parentKey]); ok {
+			matched = groups[key]
+		}
+		setRelated(reflect.ValueOf(parent).Elem().FieldByIndex(field.Index), matched)
+	}
+	return nil
+}
+
+// joinLink is a row of a join table
+type joinLink struct {
+	parent  any
+	related any
+}
+
+// joinLinksScanner scans the foreign key and the association key of join table rows,
+// text keys are scanned as strings so that they can be matched with relationKey
+type joinLinksScanner struct {
+	links *[]joinLink
+}
+
+func (s *joinLinksScanner) Scan(rows *sql.Rows) error {
+	var links []joinLink
+	for rows.Next() {
+		var link joinLink
+		if err := rows.Scan(&link.parent, &link.related); err != nil {
+			return err
+		}
+		link.parent, link.related = bytesToString(link.parent), bytesToString(link.related)
+		links = append(links, link)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	*s.links = links
+	return nil
+}
+
+func bytesToString(v any) any {
+	if b, ok := v.([]byte); ok {
+		return string(b)
+	}
+	return v
+}
@@ -0,0 +1,91 @@
+package lorm
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssociations(t *testing.T) {
+	engine := newSQLiteEngine(t,
+		"CREATE TABLE assoc_user (id INTEGER PRIMARY KEY, name TEXT)",
+		"CREATE TABLE assoc_role (id INTEGER PRIMARY KEY, name TEXT)",
+		"CREATE TABLE user_roles (user_id INTEGER, role_id INTEGER, PRIMARY KEY (user_id, role_id))",
+		"INSERT INTO assoc_user VALUES (1, 'a'), (2, 'b')",
+		"INSERT INTO assoc_role VALUES (1, 'admin'), (2, 'editor'), (3, 'viewer')",
+	)
+	ctx := context.TODO()
+	admin, editor, viewer := &assocRole{ID: 1}, &assocRole{ID: 2}, &assocRole{ID: 3}
+	a, b := &assocUser{ID: 1}, &assocUser{ID: 2}
+
+	assert.NoError(t, Associate(ctx, engine, a, "Roles", admin, editor))
+	// linked roles are skipped
+	assert.NoError(t, Associate(ctx, engine, a, "Roles", editor, viewer))
+	assert.NoError(t, Associate(ctx, engine, b, "Roles", viewer))
+
+	assert.NoError(t, LoadAssociations(ctx, engine, "Roles", a, b))
+	assert.Equal(t, []string{"admin", "editor", "viewer"}, roleNames(a.Roles))
+	assert.Equal(t, []string{"viewer"}, roleNames(b.Roles))
+
+	assert.NoError(t, Dissociate(ctx, engine, a, "Roles", editor))
+	users, err := Query[*assocUser](engine).Preload("Roles").OrderBy("id").Find(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, users, 2) {
+		assert.Equal(t, []string{"admin", "viewer"}, roleNames(users[0].Roles))
+	}
+
+	// the links are left unchanged when the transaction fails
+	err = engine.TX(ctx, func(ctx context.Context) error {
+		if err := ReplaceAssociations(ctx, engine, a, "Roles", editor); err != nil {
+			return err
+		}
+		return errors.New("abort")
+	})
+	assert.EqualError(t, err, "abort")
+	assert.NoError(t, LoadAssociations(ctx, engine, "Roles", a))
+	assert.Equal(t, []string{"admin", "viewer"}, roleNames(a.Roles))
+
+	assert.NoError(t, ReplaceAssociations(ctx, engine, a, "Roles", editor))
+	assert.NoError(t, ReplaceAssociations[*assocUser, *assocRole](ctx, engine, b, "Roles"))
+	assert.NoError(t, LoadAssociations(ctx, engine, "Roles", a, b))
+	assert.Equal(t, []string{"editor"}, roleNames(a.Roles))
+	assert.Nil(t, b.Roles)
+
+	err = Associate(ctx, engine, admin, "Users", a)
+	assert.EqualError(t, err, `lorm: assocRole has no relation "Users"`)
+}
+
+// TestAssociationConvertedKeys links models whose primary key is stored through a converter
+func TestAssociationConvertedKeys(t *testing.T) {
+	engine := newConverterEngine(t)
+	ctx := context.TODO()
+	_, err := engine.Exec(ctx, "CREATE TABLE user_hosts (user_id INTEGER, addr TEXT, PRIMARY KEY (user_id, addr))")
+	assert.NoError(t, err)
+	a := &assocUser{ID: 1}
+	first := &converterKeyModel{Addr: netip.MustParseAddr("10.0.0.1")}
+	second := &converterKeyModel{Addr: netip.MustParseAddr("10.0.0.2")}
+	hosts := func() []string {
+		addrs, err := QueryCol[string](engine).From("user_hosts").Columns("addr").OrderBy("addr").Find(ctx)
+		assert.NoError(t, err)
+		return addrs
+	}
+
+	assert.NoError(t, Associate(ctx, engine, a, "Hosts", first))
+	// the linked key is matched with its converted value and skipped
+	assert.NoError(t, Associate(ctx, engine, a, "Hosts", first, second))
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, hosts())
+
+	assert.NoError(t, Dissociate(ctx, engine, a, "Hosts", first))
+	assert.Equal(t, []string{"10.0.0.2"}, hosts())
+}
+
+func roleNames(roles []*assocRole) []string {
+	var result []string
+	for _, role := range roles {
+		result = append(result, role.Name)
+	}
+	return result
+}
@@ -0,0 +1,52 @@
+package lorm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type auditUserKey struct{}
+
+func TestAuditFields(t *testing.T) {
+	engine := newSQLiteEngine(t, "CREATE TABLE audit_model (id INTEGER PRIMARY KEY, name TEXT, created_by INTEGER, updated_by TEXT)")
+	WithAuditor(func(ctx context.Context) any {
+		return ctx.Value(auditUserKey{})
+	})(engine.config)
+	ctx := context.WithValue(context.TODO(), auditUserKey{}, 7)
+
+	_, err := InsertAll(ctx, engine, []*auditModel{{ID: 1}, {ID: 2, CreatedBy: 3}})
+	assert.NoError(t, err)
+	found, err := Query[*auditModel](engine).OrderBy("id").Find(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, found, 2) {
+		assert.EqualValues(t, 7, found[0].CreatedBy)
+		assert.Equal(t, "7", *found[0].UpdatedBy)
+		assert.EqualValues(t, 3, found[1].CreatedBy)
+	}
+
+	// without a user the fields are left unchanged
+	m := &auditModel{ID: 3}
+	_, err = Insert(context.TODO(), engine, m)
+	assert.NoError(t, err)
+	assert.Zero(t, m.CreatedBy)
+	assert.Nil(t, m.UpdatedBy)
+
+	m.Name = "n"
+	_, err = Update(engine).SetModel(m).Exec(context.WithValue(context.TODO(), auditUserKey{}, "9"))
+	assert.NoError(t, err)
+	updatedBy, _, err := QueryCol[string](engine).From("audit_model").Columns("updated_by").Where("id = ?", 3).Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "9", updatedBy)
+	assert.Zero(t, m.CreatedBy)
+
+	// the updater of a loaded model is overwritten, its creator is kept
+	found[0].Name = "m"
+	_, err = Update(engine).SetModel(found[0]).Exec(context.WithValue(context.TODO(), auditUserKey{}, 11))
+	assert.NoError(t, err)
+	loaded, err := Query[*auditModel](engine).Where("id = ?", 1).MustGet(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "11", *loaded.UpdatedBy)
+	assert.EqualValues(t, 7, loaded.CreatedBy)
+}
@@ -0,0 +1,119 @@
+package lorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+type batchOptions struct {
+	tx bool
+}
+
+type BatchOption func(*batchOptions)
+
+// WithBatchTX runs the query and the callback of every batch in its own transaction,
+// a failed batch is rolled back and the batches before it stay committed,
+// if ctx already carries a transaction the batches run in it and are committed or rolled back with it
+func WithBatchTX() BatchOption {
+	return func(o *batchOptions) {
+		o.tx = true
+	}
+}
+
+// FindInBatches walks the models of the query in batches of size models ordered by the primary keys and calls fn with every batch,
+// the batches are selected by keyset predicates on the primary keys instead of OFFSET so that deep batches stay fast,
+// the ORDER BY and OFFSET of the query are dropped and its LIMIT caps the total number of models walked,
+// the primary keys are qualified with the table name or the alias of FROM when the query joins other tables,
+// it stops at the first error returned by fn and returns it, eg:
+//
+//	err := Query[*User](engine).Where("status = ?", 1).FindInBatches(ctx, 1000, func(ctx context.Context, users []*User) error {
+//		...
+//	}, lorm.WithBatchTX())
+func (s *QueryModelStmt[T]) FindInBatches(ctx context.Context, size uint64, fn func(context.Context, []T) error, opts ...BatchOption) error {
+	if size == 0 {
+		return errors.New("size can not be zero")
+	}
+	var o batchOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var t T
+	descriptor := t.LormModelDescriptor()
+	fields := descriptorFieldMap(descriptor)
+	primaryKeys := descriptor.FlagFields(FlagPrimaryKey)
+	if len(primaryKeys) == 0 {
+		return fmt.Errorf("lorm: %s has no primary key", descriptor.Name)
+	}
+	var qualifier string
+	if s.builder.HasJoins() {
+		if qualifier = s.builder.FromName(); qualifier == "" {
+			qualifier = descriptor.TableName
+		}
+	}
+	keys := make([]sortKey, len(primaryKeys))
+	for i, primaryKey := range primaryKeys {
+		expr := primaryKey
+		if qualifier != "" {
+			expr = qualifier + "." + primaryKey
+		}
+		keys[i] = sortKey{expr: s.engine.Escaper().Escape(expr), field: fields[primaryKey]}
+	}
+	// remaining is the number of models left under the LIMIT of the query
+	remaining, limited := s.builder.GetLimit()
+
+	// last are the primary keys of the last model of the previous batch
+	var last []any
+	for {
+		limit := size
+		if limited {
+			if remaining == 0 {
+				return nil
+			}
+			limit = min(limit, remaining)
+		}
+		stmt := s.clone()
+		stmt.builder.RemoveOrderBy().RemoveOffset().Limit(limit)
+		for _, key := range keys {
+			stmt.builder.OrderBy(key.orderBy(false))
+		}
+		if last != nil {
+			stmt.whereKeys(keys, last, false)
+		}
+		var batch []T
+		run := func(ctx context.Context) (err error) {
+			if batch, err = stmt.Find(ctx); err != nil || len(batch) == 0 {
+				return err
+			}
+			last = keyValues(s.engine, batch[len(batch)-1], keys)
+			return fn(ctx, batch)
+		}
+		var err error
+		if o.tx {
+			err = s.engine.TX(ctx, run)
+		} else {
+			err = run(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		if uint64(len(batch)) < limit {
+			return nil
+		}
+		remaining -= uint64(len(batch))
+	}
+}
+
+// keyValues copies the values of keys in model to bind them, it is called before fn so that fn can change the model
+func keyValues(engine *Engine, model Model, keys []sortKey) []any {
+	fieldMap := model.LormFieldMap()
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		value := reflect.ValueOf(fieldMap[key.field.DBField]).Elem()
+		ptr := reflect.New(value.Type())
+		ptr.Elem().Set(value)
+		values[i] = engine.wrapField(key.field, ptr.Interface())
+	}
+	return values
+}
@@ -0,0 +1,89 @@
+package lorm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yvvlee/lorm/builder"
+)
+
+func TestFindInBatches(t *testing.T) {
+	engine := newPlanEngine(t, 8)
+	ctx := context.TODO()
+
+	var batches [][]int64
+	err := Query[*planModel](engine).Where("id <> ?", 4).OrderBy("name DESC").Offset(2).
+		FindInBatches(ctx, 3, func(ctx context.Context, models []*planModel) error {
+			batches = append(batches, planIDs(models))
+			// changing the keys does not move the next batch
+			models[len(models)-1].ID = 0
+			return nil
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int64{{1, 2, 3}, {5, 6, 7}, {8}}, batches)
+
+	// the LIMIT of the query caps the total number of models
+	batches = nil
+	err = Query[*planModel](engine).Limit(5).FindInBatches(ctx, 3, func(ctx context.Context, models []*planModel) error {
+		batches = append(batches, planIDs(models))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int64{{1, 2, 3}, {4, 5}}, batches)
+
+	// the primary keys are not ambiguous in joins
+	batches = nil
+	err = Query[*planModel](engine).Select("plan_model.id", "plan_model.name", "plan_model.score", "plan_model.tags").
+		Join("plan_model AS other ON other.id = plan_model.id").Where("other.id > ?", 2).
+		FindInBatches(ctx, 4, func(ctx context.Context, models []*planModel) error {
+			batches = append(batches, planIDs(models))
+			return nil
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int64{{3, 4, 5, 6}, {7, 8}}, batches)
+
+	// the primary keys are qualified with the alias of FROM
+	for _, stmt := range []*QueryModelStmt[*planModel]{
+		Query[*planModel](engine).From("plan_model AS p").Where("p.id > ?", 5),
+		Query[*planModel](engine).From("plan_model p").Select("p.id", "p.name", "p.score", "p.tags").
+			Join("plan_model AS other ON other.id = p.id").Where("other.id > ?", 5),
+	} {
+		batches = nil
+		err = stmt.FindInBatches(ctx, 2, func(ctx context.Context, models []*planModel) error {
+			batches = append(batches, planIDs(models))
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, [][]int64{{6, 7}, {8}}, batches)
+	}
+
+	batches = nil
+	err = Query[*planModel](engine).Where("id <= ?", 6).FindInBatches(ctx, 3, func(ctx context.Context, models []*planModel) error {
+		batches = append(batches, planIDs(models))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int64{{1, 2, 3}, {4, 5, 6}}, batches)
+
+	// every batch runs in its own transaction, the failed batch is rolled back
+	stop := errors.New("stop")
+	err = Query[*planModel](engine).FindInBatches(ctx, 5, func(ctx context.Context, models []*planModel) error {
+		ids := planIDs(models)
+		if _, err := Delete(engine).From("plan_model").Where(builder.In{Col: "id", Val: ids}).Exec(ctx); err != nil {
+			return err
+		}
+		if ids[0] > 5 {
+			return stop
+		}
+		return nil
+	}, WithBatchTX())
+	assert.ErrorIs(t, err, stop)
+	ids, err := Pluck[*planModel, int64](ctx, Query[*planModel](engine).OrderBy("id"), "id")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{6, 7, 8}, ids)
+
+	err = Query[*planModel](engine).FindInBatches(ctx, 0, nil)
+	assert.EqualError(t, err, "size can not be zero")
+}
@@ -3,6 +3,7 @@ package builder
 import (
 	"bytes"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -123,16 +124,7 @@ func (b *SelectBuilder) ToSql() (sqlStr string, args []any, err error) {
 
 func (b *SelectBuilder) ToCountBuilder() *SelectBuilder {
 	if len(b.groupBys) == 0 {
-		builder := &SelectBuilder{
-			prefixes:   b.prefixes,
-			options:    b.options,
-			columns:    nil,
-			from:       b.from,
-			joins:      b.joins,
-			whereParts: b.whereParts,
-			suffixes:   b.suffixes,
-		}
-		return builder.Select("COUNT(1)")
+		return b.ToAggregateBuilder("COUNT(1)")
 	}
 
 	if len(b.groupBys) == 1 &&
@@ -167,6 +159,36 @@ func (b *SelectBuilder) ToCountBuilder() *SelectBuilder {
 		FromSelect(subBuilder, "sub")
 }
 
+// Clone returns a copy of the builder, changing the copy does not change the builder
+func (b *SelectBuilder) Clone() *SelectBuilder {
+	clone := *b
+	clone.prefixes = slices.Clone(b.prefixes)
+	clone.options = slices.Clone(b.options)
+	clone.columns = slices.Clone(b.columns)
+	clone.joins = slices.Clone(b.joins)
+	clone.whereParts = slices.Clone(b.whereParts)
+	clone.groupBys = slices.Clone(b.groupBys)
+	clone.havingParts = slices.Clone(b.havingParts)
+	clone.orderByParts = slices.Clone(b.orderByParts)
+	clone.suffixes = slices.Clone(b.suffixes)
+	return &clone
+}
+
+// ToAggregateBuilder returns a builder selecting the aggregate expr over the rows matched by the FROM, JOIN and WHERE clauses,
+// GROUP BY, HAVING, ORDER BY, LIMIT and OFFSET are dropped, eg: ToAggregateBuilder("MAX(age)")
+func (b *SelectBuilder) ToAggregateBuilder(expr string) *SelectBuilder {
+	builder := &SelectBuilder{
+		prefixes:   b.prefixes,
+		options:    b.options,
+		columns:    nil,
+		from:       b.from,
+		joins:      b.joins,
+		whereParts: b.whereParts,
+		suffixes:   b.suffixes,
+	}
+	return builder.Select(expr)
+}
+
 // Prefix adds an expression to the beginning of the query
 func (b *SelectBuilder) Prefix(sql string, args ...any) *SelectBuilder {
 	return b.PrefixExpr(Expr(sql, args...))
@@ -229,6 +251,27 @@ func (b *SelectBuilder) FromSelect(from *SelectBuilder, alias string) *SelectBui
 	return b
 }
 
+// FromName returns the name the columns of the FROM clause are qualified by, eg: u for "users AS u",
+// the table for "users" and the alias of FromSelect, it returns an empty string if there is no FROM clause.
+func (b *SelectBuilder) FromName() string {
+	switch from := b.from.(type) {
+	case aliasExpr:
+		return from.alias
+	case *part:
+		if s, ok := from.pred.(string); ok {
+			if fields := strings.Fields(s); len(fields) > 0 {
+				return strings.Trim(fields[len(fields)-1], "`\"[]")
+			}
+		}
+	}
+	return ""
+}
+
+// HasJoins reports whether the query has JOIN clauses.
+func (b *SelectBuilder) HasJoins() bool {
+	return len(b.joins) > 0
+}
+
 // JoinClause adds a join clause to the query.
 func (b *SelectBuilder) JoinClause(pred any, args ...any) *SelectBuilder {
 	b.joins = append(b.joins, newPart(pred, args...))
@@ -317,12 +360,40 @@ func (b *SelectBuilder) OrderBy(orderBys ...string) *SelectBuilder {
 	return b
 }
 
+// OrderBys returns the SQL of the ORDER BY expressions of the query, the args of the expressions are dropped
+func (b *SelectBuilder) OrderBys() ([]string, error) {
+	orderBys := make([]string, 0, len(b.orderByParts))
+	for _, part := range b.orderByParts {
+		sql, _, err := part.ToSql()
+		if err != nil {
+			return nil, err
+		}
+		orderBys = append(orderBys, sql)
+	}
+	return orderBys, nil
+}
+
+// RemoveOrderBy removes the ORDER BY clause
+func (b *SelectBuilder) RemoveOrderBy() *SelectBuilder {
+	b.orderByParts = nil
+	return b
+}
+
 // Limit sets a LIMIT clause on the query.
 func (b *SelectBuilder) Limit(limit uint64) *SelectBuilder {
 	b.limit = strconv.FormatUint(limit, 10)
 	return b
 }
 
+// GetLimit returns the LIMIT of the query, ok is false if the query has no LIMIT
+func (b *SelectBuilder) GetLimit() (limit uint64, ok bool) {
+	if b.limit == "" {
+		return 0, false
+	}
+	limit, err := strconv.ParseUint(b.limit, 10, 64)
+	return limit, err == nil
+}
+
 // RemoveLimit remove LIMIT clause
 func (b *SelectBuilder) RemoveLimit() *SelectBuilder {
 	b.limit = ""
@@ -166,6 +166,23 @@ func TestCountBuilder(t *testing.T) {
 	})
 }
 
+func TestAggregateBuilder(t *testing.T) {
+	b := Select("u.id", "u.name").
+		From("users u").
+		Join("orders o ON o.user_id = u.id").
+		Where("u.age > ?", 18).
+		GroupBy("u.id").
+		Having("COUNT(*) > ?", 1).
+		OrderBy("u.id").
+		Limit(10).
+		Offset(20)
+
+	sql, args, err := b.ToAggregateBuilder("SUM(o.amount)").ToSql()
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT SUM(o.amount) FROM users u JOIN orders o ON o.user_id = u.id WHERE u.age > ?", sql)
+	assert.Equal(t, []any{18}, args)
+}
+
 func TestSelectBuilderFromSelect(t *testing.T) {
 	subQ := Select("c").From("d").Where(Eq{"i": 0})
 	b := Select("a", "b").FromSelect(subQ, "subq")
@@ -287,6 +304,43 @@ func TestSelectWithRemoveOffset(t *testing.T) {
 	assert.Equal(t, "SELECT * FROM foo", sql)
 }
 
+func TestSelectBuilderClone(t *testing.T) {
+	b := Select("a").From("foo").Where("a > ?", 1).OrderBy("a")
+	clone := b.Clone().Where("b = ?", 2).OrderBy("b").Limit(10).Offset(20)
+
+	sql, args, err := b.ToSql()
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT a FROM foo WHERE a > ? ORDER BY a", sql)
+	assert.Equal(t, []any{1}, args)
+
+	sql, args, err = clone.ToSql()
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT a FROM foo WHERE a > ? AND b = ? ORDER BY a, b LIMIT 10 OFFSET 20", sql)
+	assert.Equal(t, []any{1, 2}, args)
+}
+
+func TestSelectOrderBys(t *testing.T) {
+	b := Select("*").From("foo").OrderBy("a DESC", "b").OrderByClause("FIELD(c, ?)", 1)
+	orderBys, err := b.OrderBys()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a DESC", "b", "FIELD(c, ?)"}, orderBys)
+
+	sql, _, err := b.RemoveOrderBy().OrderBy("d").ToSql()
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM foo ORDER BY d", sql)
+}
+
+func TestSelectGetLimit(t *testing.T) {
+	b := Select("*").From("foo")
+	_, ok := b.GetLimit()
+	assert.False(t, ok)
+	limit, ok := b.Limit(10).GetLimit()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(10), limit)
+	_, ok = b.RemoveLimit().GetLimit()
+	assert.False(t, ok)
+}
+
 func TestSelectBuilderNestedSelectDollar(t *testing.T) {
 	nestedBuilder := Select("*").Prefix("NOT EXISTS (").
 		From("bar").Where("y = ?", 42).Suffix(")")
@@ -463,3 +517,13 @@ func TestRemoveColumns(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "SELECT name FROM users", sql)
 }
+
+func TestSelectFromName(t *testing.T) {
+	assert.Equal(t, "", Select("a").FromName())
+	assert.Equal(t, "users", Select("a").From("users").FromName())
+	assert.Equal(t, "u", Select("a").From("users AS u").FromName())
+	assert.Equal(t, "u", Select("a").From("`users` `u`").FromName())
+	assert.Equal(t, "sub", Select("a").FromSelect(Select("a").From("users"), "sub").FromName())
+	assert.False(t, Select("a").From("users").HasJoins())
+	assert.True(t, Select("a").From("users u").Join("orders o ON o.user_id = u.id").HasJoins())
+}
@@ -8,13 +8,17 @@ import (
 	"go/ast"
 	"go/format"
 	"go/token"
+	"go/types"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 	"text/template"
 
+	json "github.com/bytedance/sonic"
 	"github.com/samber/lo"
 	"github.com/yvvlee/lorm"
 	"golang.org/x/tools/go/packages"
@@ -63,10 +67,19 @@ func (g *Generator) Generate(files []string) error {
 	}
 	for _, pkg := range pkgs {
 		for _, file := range pkg.Syntax {
-			fileInfo := g.extractFile(file)
+			fileInfo, err := g.extractFile(pkg, file)
+			if err != nil {
+				return err
+			}
 			if fileInfo == nil {
 				continue
 			}
+			if err := checkDefaults(fileInfo); err != nil {
+				return err
+			}
+			if err := checkTimeFlags(fileInfo); err != nil {
+				return err
+			}
 			generatedFilePath, err := g.generateFile(fileInfo)
 			if err != nil {
 				return err
@@ -77,6 +90,67 @@ func (g *Generator) Generate(files []string) error {
 	return nil
 }
 
+// checkDefaults rejects the default values declared in tags which can not be converted to the types of their fields,
+// only JSON fields and fields of builtin numeric and bool types are checked, the other types are converted at runtime
+func checkDefaults(file *lorm.FileDescriptor) error {
+	for _, model := range file.Structs {
+		for _, field := range model.Fields {
+			if err := checkDefault(field); err != nil {
+				return fmt.Errorf("%s: %s.%s: invalid default %q: %w", file.Path, model.Name, field.Name, field.Default, err)
+			}
+		}
+	}
+	return nil
+}
+
+// checkTimeFlags rejects unix_milli and unix_nano on integer fields narrower than 64 bits, the times would overflow them
+func checkTimeFlags(file *lorm.FileDescriptor) error {
+	for _, model := range file.Structs {
+		for _, field := range model.Fields {
+			for _, flag := range []lorm.FieldFlag{lorm.FlagUnixMilli, lorm.FlagUnixNano} {
+				if !field.Flag.HasFlag(flag) {
+					continue
+				}
+				switch typ := strings.TrimPrefix(field.Type, "*"); typ {
+				case "int8", "int16", "int32", "uint8", "uint16", "uint32":
+					return fmt.Errorf("%s: %s.%s: %s overflows %s, use int64 or uint64", file.Path, model.Name, field.Name, lorm.FlagTagMap[flag], typ)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func checkDefault(field *lorm.FieldDescriptor) error {
+	if !field.Flag.HasFlag(lorm.FlagDefault) || field.Default == "" {
+		return nil
+	}
+	if field.Flag.HasFlag(lorm.FlagJson) {
+		if !json.ValidString(field.Default) {
+			return errors.New("not a valid JSON value")
+		}
+		return nil
+	}
+	var err error
+	switch typ := strings.TrimPrefix(field.Type, "*"); typ {
+	case "bool":
+		_, err = strconv.ParseBool(field.Default)
+	case "int", "int8", "int16", "int32", "int64":
+		_, err = strconv.ParseInt(field.Default, 10, bitSize(typ, "int"))
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		_, err = strconv.ParseUint(field.Default, 10, bitSize(typ, "uint"))
+	case "float32", "float64":
+		_, err = strconv.ParseFloat(field.Default, bitSize(typ, "float"))
+	}
+	return err
+}
+
+// bitSize returns the bit size of a numeric type name, eg: 32 for int32, 0 for int
+func bitSize(typ, prefix string) int {
+	size, _ := strconv.Atoi(strings.TrimPrefix(typ, prefix))
+	return size
+}
+
 func (g *Generator) generateFile(file *lorm.FileDescriptor) (string, error) {
 	content, err := generateCode(file)
 	if err != nil {
@@ -125,14 +199,14 @@ func (g *Generator) load(files []string) ([]*packages.Package, error) {
 	return pkgs, nil
 }
 
-// extractFile extracts struct information from AST file
-func (g *Generator) extractFile(file *ast.File) *lorm.FileDescriptor {
+// extractFile extracts struct information from AST file, the type information of pkg is used to flatten embedded structs
+func (g *Generator) extractFile(pkg *packages.Package, file *ast.File) (*lorm.FileDescriptor, error) {
 	lormImportSpec, ok := lo.Find(file.Imports, func(item *ast.ImportSpec) bool {
 		return strings.Trim(item.Path.Value, "\"") == lormPackage
 	})
 	if !ok {
 		//如果没有导入lorm包，则不处理
-		return nil
+		return nil, nil
 	}
 	tokenFile := g.fileSet.File(file.Pos())
 	filePath := tokenFile.Name()
@@ -162,7 +236,11 @@ func (g *Generator) extractFile(file *ast.File) *lorm.FileDescriptor {
 		Structs: nil,
 	}
 
+	var err error
 	ast.Inspect(file, func(n ast.Node) bool {
+		if err != nil {
+			return false
+		}
 		switch x := n.(type) {
 		case *ast.GenDecl:
 			if x.Tok == token.TYPE {
@@ -187,7 +265,11 @@ func (g *Generator) extractFile(file *ast.File) *lorm.FileDescriptor {
 						fieldType := exprToString(field.Type)
 						if fieldType == unimplementedTable {
 							hasModel = true
-							structInfo.TableName, _ = parseTag(field, g.tagKey)
+							tag, tagErr := parseTag(field, g.tagKey)
+							if tagErr != nil {
+								err = fmt.Errorf("%s: %s: %w", fileRefPath, structInfo.Name, tagErr)
+							}
+							structInfo.TableName = tag.name
 							if structInfo.TableName == "" {
 								structInfo.TableName = g.tableMapper.ConvertName(structInfo.Name)
 							}
@@ -199,37 +281,33 @@ func (g *Generator) extractFile(file *ast.File) *lorm.FileDescriptor {
 						}
 						return true
 					})
+					if err != nil {
+						return false
+					}
 					if !hasModel {
 						continue
 					}
 
 					// 遍历结构体字段
 					for _, field := range fields {
+						tag, tagErr := parseTag(field, g.tagKey)
+						if tagErr != nil {
+							err = fmt.Errorf("%s: %s.%s: %w", fileRefPath, structInfo.Name, fieldName(field), tagErr)
+							return false
+						}
 						if len(field.Names) == 0 {
-							// Embedded field
-							embedFieldPrefix, _ := parseTag(field, g.tagKey)
-							if ident, ok := field.Type.(*ast.Ident); ok {
-								if ts, ok := ident.Obj.Decl.(*ast.TypeSpec); ok {
-									if st, ok := ts.Type.(*ast.StructType); ok {
-										for _, embedField := range st.Fields.List {
-											fieldList := g.parseField(embedField)
-											if len(fieldList) > 0 {
-												for _, f := range fieldList {
-													f.FullName = ident.Name + "." + f.Name
-													f.DBField = embedFieldPrefix + f.DBField
-												}
-												structInfo.Fields = append(structInfo.Fields, fieldList...)
-											}
-										}
-									}
-								}
+							// Embedded field, its type may be declared in another package, be a pointer or be generic
+							typ := pkg.TypesInfo.TypeOf(field.Type)
+							if tagErr = g.parseEmbedded(structInfo, typ, embeddedName(typ), tag.name, pkg.Types, g.qualifier(pkg.Types, file)); tagErr != nil {
+								err = fmt.Errorf("%s: %s: %w", fileRefPath, structInfo.Name, tagErr)
+								return false
 							}
+						} else if tag.relation != nil {
+							// Relation field
+							structInfo.Relations = append(structInfo.Relations, g.parseRelation(structInfo.Name, field, tag.relation)...)
 						} else {
 							// Regular field
-							fieldList := g.parseField(field)
-							if len(fields) > 0 {
-								structInfo.Fields = append(structInfo.Fields, fieldList...)
-							}
+							structInfo.Fields = append(structInfo.Fields, g.parseField(field, tag)...)
 						}
 					}
 					fileInfo.Structs = append(fileInfo.Structs, structInfo)
@@ -239,11 +317,102 @@ func (g *Generator) extractFile(file *ast.File) *lorm.FileDescriptor {
 		}
 		return true
 	})
-	return &fileInfo
+	if err != nil {
+		return nil, err
+	}
+	return &fileInfo, nil
 }
 
-func (g *Generator) parseField(field *ast.Field) []*lorm.FieldDescriptor {
-	dbField, flag := parseTag(field, g.tagKey)
+// parseEmbedded flattens the fields of the embedded struct typ into structInfo, path is the selector of the embedded field,
+// the db fields are prefixed with prefix, unexported fields of other packages are skipped
+func (g *Generator) parseEmbedded(structInfo *lorm.ModelDescriptor, typ types.Type, path, prefix string, local *types.Package, qualifier types.Qualifier) error {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+		structInfo.PointerEmbeds = append(structInfo.PointerEmbeds, &lorm.EmbedDescriptor{
+			Path: path,
+			Type: types.TypeString(typ, qualifier),
+		})
+	}
+	st, ok := typ.Underlying().(*types.Struct)
+	if !ok {
+		return nil
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		v := st.Field(i)
+		if !v.Exported() && v.Pkg() != local {
+			continue
+		}
+		fieldPath := path + "." + v.Name()
+		tag, err := parseStructTag(reflect.StructTag(st.Tag(i)), g.tagKey)
+		if err != nil {
+			return fmt.Errorf("%s: %w", fieldPath, err)
+		}
+		fieldType := types.TypeString(v.Type(), qualifier)
+		switch {
+		case v.Embedded():
+			if err := g.parseEmbedded(structInfo, v.Type(), fieldPath, prefix+tag.name, local, qualifier); err != nil {
+				return err
+			}
+		case tag.relation != nil:
+			structInfo.Relations = append(structInfo.Relations, g.newRelation(structInfo.Name, v.Name(), fieldType, tag.relation))
+		default:
+			fieldInfo := &lorm.FieldDescriptor{
+				Name:     v.Name(),
+				FullName: fieldPath,
+				DBField:  prefix + g.fieldMapper.ConvertName(v.Name()),
+				Type:     fieldType,
+				Flag:     tag.flag,
+				Rules:    tag.rules,
+				Default:  tag.defaultValue,
+				Layout:   tag.layout,
+			}
+			if tag.name != "" {
+				fieldInfo.DBField = prefix + tag.name
+			}
+			if _, ok := v.Type().(*types.Pointer); ok || isNullType(fieldType) {
+				fieldInfo.Flag |= lorm.FlagNullable
+			}
+			structInfo.Fields = append(structInfo.Fields, fieldInfo)
+		}
+	}
+	return nil
+}
+
+// fieldName returns the name of field for error messages, the type name is used for embedded fields
+func fieldName(field *ast.Field) string {
+	if len(field.Names) == 0 {
+		return exprToString(field.Type)
+	}
+	return field.Names[len(field.Names)-1].Name
+}
+
+// embeddedName returns the field name of an embedded type, eg: Base for *common.Base or Base[int]
+func embeddedName(typ types.Type) string {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	if named, ok := typ.(*types.Named); ok {
+		return named.Obj().Name()
+	}
+	return types.TypeString(typ, nil)
+}
+
+// qualifier names the packages of types by their import names in file, types of the local package are not qualified
+func (g *Generator) qualifier(local *types.Package, file *ast.File) types.Qualifier {
+	return func(p *types.Package) string {
+		if p == local {
+			return ""
+		}
+		for _, spec := range file.Imports {
+			if strings.Trim(spec.Path.Value, `"`) == p.Path() && spec.Name != nil {
+				return spec.Name.Name
+			}
+		}
+		return p.Name()
+	}
+}
+
+func (g *Generator) parseField(field *ast.Field, tag fieldTag) []*lorm.FieldDescriptor {
 	var fields []*lorm.FieldDescriptor
 	for i, name := range field.Names {
 		fieldInfo := &lorm.FieldDescriptor{
@@ -252,36 +421,170 @@ func (g *Generator) parseField(field *ast.Field) []*lorm.FieldDescriptor {
 			DBField:  g.fieldMapper.ConvertName(name.Name),
 		}
 		if i == len(field.Names)-1 {
-			fieldInfo.Flag = flag
+			fieldInfo.Flag = tag.flag
+			fieldInfo.Rules = tag.rules
+			fieldInfo.Default = tag.defaultValue
+			fieldInfo.Layout = tag.layout
 			// When fields are declared in aggregation, the tag only takes effect for the last field, eg: fieldA, fieldB string `lorm:"field_b"`
-			if dbField != "" {
-				fieldInfo.DBField = dbField
+			if tag.name != "" {
+				fieldInfo.DBField = tag.name
 			}
 		}
 
 		fieldInfo.Type = exprToString(field.Type)
+		if _, ok := field.Type.(*ast.StarExpr); ok || isNullType(fieldInfo.Type) {
+			fieldInfo.Flag |= lorm.FlagNullable
+		}
 		fields = append(fields, fieldInfo)
 	}
 	return fields
 }
 
-func parseTag(field *ast.Field, tagKey string) (filed string, flag lorm.FieldFlag) {
+// isNullType reports whether typ is a generic Null type, eg: lorm.Null[string] or sql.Null[int64]
+func isNullType(typ string) bool {
+	name, _, ok := strings.Cut(typ, "[")
+	return ok && (name == "Null" || strings.HasSuffix(name, ".Null"))
+}
+
+// parseRelation returns the relations declared on field, the foreign key defaults to the model name with an ID suffix
+// for has_one, has_many and many_to_many, eg: user_id, and to the field name with an ID suffix for belongs_to,
+// the association key of many_to_many defaults to the related type name with an ID suffix, eg: role_id
+func (g *Generator) parseRelation(modelName string, field *ast.Field, relation *lorm.RelationDescriptor) []*lorm.RelationDescriptor {
+	return lo.Map(field.Names, func(name *ast.Ident, _ int) *lorm.RelationDescriptor {
+		return g.newRelation(modelName, name.Name, exprToString(field.Type), relation)
+	})
+}
+
+func (g *Generator) newRelation(modelName, name, typ string, relation *lorm.RelationDescriptor) *lorm.RelationDescriptor {
+	r := *relation
+	r.Name = name
+	r.Type = typ
+	if r.ForeignKey == "" {
+		switch r.Kind {
+		case lorm.RelBelongsTo:
+			r.ForeignKey = g.fieldMapper.ConvertName(name + "ID")
+		default:
+			r.ForeignKey = g.fieldMapper.ConvertName(modelName + "ID")
+		}
+	}
+	if r.Kind == lorm.RelManyToMany && r.AssociationKey == "" {
+		typeName := strings.TrimLeft(r.Type, "[]*")
+		if i := strings.LastIndex(typeName, "."); i >= 0 {
+			typeName = typeName[i+1:]
+		}
+		r.AssociationKey = g.fieldMapper.ConvertName(typeName + "ID")
+	}
+	return &r
+}
+
+// fieldTag is the parsed tag of a field
+type fieldTag struct {
+	// name is the db field name, the table name or the embedded field prefix, depending on the field
+	name         string
+	flag         lorm.FieldFlag
+	rules        []*lorm.Rule
+	defaultValue string
+	layout       string
+	relation     *lorm.RelationDescriptor
+}
+
+func parseTag(field *ast.Field, tagKey string) (tag fieldTag, err error) {
 	if field == nil || field.Tag == nil {
 		return
 	}
-	tagString := reflect.StructTag(strings.Trim(field.Tag.Value, "`")).Get(tagKey)
-	flags := lo.Uniq(strings.Split(tagString, ","))
+	return parseStructTag(reflect.StructTag(strings.Trim(field.Tag.Value, "`")), tagKey)
+}
+
+func parseStructTag(structTag reflect.StructTag, tagKey string) (tag fieldTag, err error) {
+	items := splitTag(structTag.Get(tagKey))
+	// The first item is the column name unless it is a flag or an option, eg: `lorm:"email,required"` or `lorm:"json"`
+	switch first := items[0]; {
+	case slices.Contains(columnLikeFlags, first):
+		if !slices.Contains(items[1:], first) {
+			return tag, fmt.Errorf("invalid tag %q: %s is ambiguous as the first item, use \",%s\" for the flag or \"%s,%s\" for the column with the flag", structTag, first, first, first, first)
+		}
+		tag.name, items = first, items[1:]
+	case !strings.Contains(first, ":") && !slices.Contains(lo.Values(lorm.FlagTagMap), first):
+		tag.name, items = first, items[1:]
+	}
+	items = lo.Uniq(items)
 	for fieldFlag, key := range lorm.FlagTagMap {
-		if parseFlag(&flags, key) {
-			flag |= fieldFlag
+		if parseFlag(&items, key) {
+			tag.flag |= fieldFlag
+		}
+	}
+	// Go-side default values are declared as `lorm:"default:18"`
+	if value, ok := parseOption(&items, "default:"); ok {
+		tag.flag |= lorm.FlagDefault
+		tag.defaultValue = value
+	}
+	// The time layout of created and updated string fields is declared as `lorm:"created,layout:2006-01-02 15:04:05.000"`
+	tag.layout, _ = parseOption(&items, "layout:")
+	// Relations are declared as `lorm:"rel:has_many,fk:user_id"`, the foreign key and the referenced column are optional
+	if kind, ok := parseOption(&items, "rel:"); ok {
+		tag.relation = &lorm.RelationDescriptor{Kind: lorm.RelationKind(kind)}
+		tag.relation.ForeignKey, _ = parseOption(&items, "fk:")
+		tag.relation.References, _ = parseOption(&items, "ref:")
+		tag.relation.JoinTable, _ = parseOption(&items, "join:")
+		tag.relation.AssociationKey, _ = parseOption(&items, "assoc:")
+	}
+	// Built-in validation rules can be declared in the lorm tag, eg: `lorm:"email,not_null,size:255"`
+	var rules []string
+	items = lo.Filter(items, func(item string, _ int) bool {
+		name, _, _ := strings.Cut(item, ":")
+		if _, ok := lorm.LookupValidator(name); !ok {
+			return true
 		}
+		rules = append(rules, item)
+		return false
+	})
+	// The column name follows the flags when they come first, eg: `lorm:"primary_key,uid"`
+	if tag.name == "" && len(items) > 0 {
+		tag.name = items[0]
 	}
-	if len(flags) > 0 {
-		filed = flags[0]
+	// Any rule registered by lorm.RegisterValidator can be declared in the validate tag, eg: `validate:"required,size:255"`
+	for _, item := range append(rules, splitTag(structTag.Get("validate"))...) {
+		if item == "" {
+			continue
+		}
+		name, param, _ := strings.Cut(item, ":")
+		if param == "" && slices.Contains(paramRules, name) {
+			return tag, fmt.Errorf("invalid tag %q: rule %s requires a parameter", structTag, name)
+		}
+		tag.rules = append(tag.rules, &lorm.Rule{Name: name, Param: param})
 	}
 	return
 }
 
+// columnLikeFlags are the flags which are also common column names, they are rejected as the first item of a tag
+// unless repeated, eg: `lorm:"created_by,created_by"`, so that `lorm:"created_by"` is neither silently a flag nor a name
+var columnLikeFlags = []string{"created_by", "updated_by", "nullable", "encrypted", "csv", "gob"}
+
+// paramRules are the built-in rules which can not be declared without a parameter
+var paramRules = []string{"size", "min", "max", "regexp"}
+
+// splitTag splits a tag by comma, the regexp rule must be the last one since its pattern may contain commas
+func splitTag(tag string) []string {
+	items := strings.Split(tag, ",")
+	for i, item := range items {
+		if strings.HasPrefix(item, "regexp:") {
+			return append(items[:i], strings.Join(items[i:], ","))
+		}
+	}
+	return items
+}
+
+// parseOption removes the first item starting with prefix from items and returns the rest of it
+func parseOption(items *[]string, prefix string) (string, bool) {
+	for i, item := range *items {
+		if value, ok := strings.CutPrefix(item, prefix); ok {
+			*items = slices.Delete(*items, i, i+1)
+			return value, true
+		}
+	}
+	return "", false
+}
+
 func parseFlag(flags *[]string, key string) bool {
 	length := len(*flags)
 	*flags = lo.Without(*flags, key)
@@ -301,6 +604,14 @@ func exprToString(expr ast.Expr) string {
 		return "[]" + exprToString(x.Elt)
 	case *ast.MapType:
 		return "map[" + exprToString(x.Key) + "]" + exprToString(x.Value)
+	case *ast.IndexExpr:
+		return exprToString(x.X) + "[" + exprToString(x.Index) + "]"
+	case *ast.IndexListExpr:
+		indices := make([]string, 0, len(x.Indices))
+		for _, index := range x.Indices {
+			indices = append(indices, exprToString(index))
+		}
+		return exprToString(x.X) + "[" + strings.Join(indices, ", ") + "]"
 	default:
 		return ""
 	}
@@ -2,12 +2,14 @@ package main
 
 import (
 	"embed"
+	"go/ast"
 	"os"
 	"testing"
 
 	json "github.com/bytedance/sonic"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yvvlee/lorm"
 	"github.com/yvvlee/lorm/names"
 )
 
@@ -24,13 +26,15 @@ func Test_Generate(t *testing.T) {
 	pkgs, err := generator.load([]string{
 		"testdata/user.go",
 		"testdata/user_address.go",
+		"testdata/order.go",
 	})
 	assert.Nil(t, err)
 	assert.Len(t, pkgs, 1)
 	pkg := pkgs[0]
-	assert.Len(t, pkg.Syntax, 2)
+	assert.Len(t, pkg.Syntax, 3)
 
-	fileInfo := generator.extractFile(pkg.Syntax[0])
+	fileInfo, err := generator.extractFile(pkg, pkg.Syntax[0])
+	assert.Nil(t, err)
 	fileInfoJson, err := json.MarshalString(fileInfo)
 	assert.Nil(t, err)
 	assert.NotNil(t, fileInfo)
@@ -46,7 +50,8 @@ func Test_Generate(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, string(exceptContent), string(content))
 
-	fileInfo = generator.extractFile(pkg.Syntax[1])
+	fileInfo, err = generator.extractFile(pkg, pkg.Syntax[1])
+	assert.Nil(t, err)
 	fileInfoJson, err = json.MarshalString(fileInfo)
 	assert.Nil(t, err)
 	assert.NotNil(t, fileInfo)
@@ -61,4 +66,130 @@ func Test_Generate(t *testing.T) {
 	exceptContent, err = testdata.ReadFile("testdata/user_address_lorm_gen.go")
 	assert.Nil(t, err)
 	assert.Equal(t, string(exceptContent), string(content))
+
+	// order.go embeds a struct of another package, a generic struct and a struct pointer
+	fileInfo, err = generator.extractFile(pkg, pkg.Syntax[2])
+	assert.Nil(t, err)
+	fileInfoJson, err = json.MarshalString(fileInfo)
+	assert.Nil(t, err)
+	exceptFileInfoJson, err = testdata.ReadFile("testdata/order_file_descriptor.json")
+	assert.Nil(t, err)
+	assert.Equal(t, string(exceptFileInfoJson), fileInfoJson)
+	newFile3, err := generator.generateFile(fileInfo)
+	assert.Nil(t, err)
+	defer os.Remove(newFile3)
+	content, err = os.ReadFile(newFile3)
+	assert.Nil(t, err)
+	exceptContent, err = testdata.ReadFile("testdata/order_lorm_gen.go")
+	assert.Nil(t, err)
+	assert.Equal(t, string(exceptContent), string(content))
+}
+
+func Test_parseTag(t *testing.T) {
+	field := func(tag string) *ast.Field {
+		return &ast.Field{Tag: &ast.BasicLit{Value: "`" + tag + "`"}}
+	}
+	tag, err := parseTag(field(`lorm:"email,not_null,size:255,regexp:^[a-z]{1,3}$" validate:"min:1"`), "lorm")
+	assert.Equal(t, "email", tag.name)
+	assert.Equal(t, []*lorm.Rule{
+		{Name: "not_null"},
+		{Name: "size", Param: "255"},
+		{Name: "regexp", Param: "^[a-z]{1,3}$"},
+		{Name: "min", Param: "1"},
+	}, tag.rules)
+	assert.NoError(t, err)
+
+	tag, err = parseTag(field(`lorm:"json,primary_key"`), "lorm")
+	assert.NoError(t, err)
+	assert.Equal(t, "", tag.name)
+	assert.Equal(t, lorm.FlagJson|lorm.FlagPrimaryKey, tag.flag)
+	assert.Empty(t, tag.rules)
+
+	tag, err = parseTag(field(`lorm:"created,unix_milli,layout:2006-01-02 15:04:05.000"`), "lorm")
+	assert.NoError(t, err)
+	assert.Equal(t, "", tag.name)
+	assert.Equal(t, lorm.FlagCreated|lorm.FlagUnixMilli, tag.flag)
+	assert.Equal(t, "2006-01-02 15:04:05.000", tag.layout)
+
+	tag, err = parseTag(field(`lorm:"rel:belongs_to,fk:owner_id,ref:uid"`), "lorm")
+	assert.NoError(t, err)
+	assert.Equal(t, "", tag.name)
+	assert.Equal(t, &lorm.RelationDescriptor{Kind: lorm.RelBelongsTo, ForeignKey: "owner_id", References: "uid"}, tag.relation)
+
+	// The first item is the column name even if it is also the name of a rule
+	for _, name := range []string{"size", "min", "max", "required"} {
+		tag, err = parseTag(field(`lorm:"`+name+`"`), "lorm")
+		assert.NoError(t, err)
+		assert.Equal(t, name, tag.name)
+		assert.Empty(t, tag.rules)
+	}
+	tag, err = parseTag(field(`lorm:"size:64,not_null"`), "lorm")
+	assert.NoError(t, err)
+	assert.Equal(t, "", tag.name)
+	assert.Equal(t, []*lorm.Rule{{Name: "size", Param: "64"}, {Name: "not_null"}}, tag.rules)
+	tag, err = parseTag(field(`lorm:"primary_key,uid,required"`), "lorm")
+	assert.NoError(t, err)
+	assert.Equal(t, "uid", tag.name)
+	assert.Equal(t, []*lorm.Rule{{Name: "required"}}, tag.rules)
+
+	// Rules which need a parameter are rejected without one
+	for _, tagValue := range []string{`lorm:"name,size"`, `lorm:",min"`, `lorm:"age" validate:"max"`, `lorm:"name,regexp:"`} {
+		_, err = parseTag(field(tagValue), "lorm")
+		assert.ErrorContains(t, err, "requires a parameter", tagValue)
+	}
+
+	// Flags which are also common column names are ambiguous as the first item
+	tag, err = parseTag(field(`lorm:"created_by"`), "lorm")
+	assert.ErrorContains(t, err, "created_by is ambiguous")
+	assert.Equal(t, lorm.FieldFlag(0), tag.flag)
+	tag, err = parseTag(field(`lorm:",created_by"`), "lorm")
+	assert.NoError(t, err)
+	assert.Equal(t, "", tag.name)
+	assert.Equal(t, lorm.FlagCreatedBy, tag.flag)
+	tag, err = parseTag(field(`lorm:"created_by,created_by"`), "lorm")
+	assert.NoError(t, err)
+	assert.Equal(t, "created_by", tag.name)
+	assert.Equal(t, lorm.FlagCreatedBy, tag.flag)
+	tag, err = parseTag(field(`lorm:"creator_id,created_by"`), "lorm")
+	assert.NoError(t, err)
+	assert.Equal(t, "creator_id", tag.name)
+	assert.Equal(t, lorm.FlagCreatedBy, tag.flag)
+	for _, key := range []string{"updated_by", "nullable", "encrypted", "csv", "gob"} {
+		_, err = parseTag(field(`lorm:"`+key+`"`), "lorm")
+		assert.ErrorContains(t, err, key+" is ambiguous")
+	}
+}
+
+func Test_checkDefault(t *testing.T) {
+	field := func(typ, tag string) *lorm.FieldDescriptor {
+		parsed, _ := parseTag(&ast.Field{Tag: &ast.BasicLit{Value: "`" + tag + "`"}}, "lorm")
+		return &lorm.FieldDescriptor{Type: typ, Flag: parsed.flag, Default: parsed.defaultValue}
+	}
+	assert.NoError(t, checkDefault(field("int", `lorm:"default:18"`)))
+	assert.NoError(t, checkDefault(field("*bool", `lorm:"default:true"`)))
+	assert.NoError(t, checkDefault(field("[]string", `lorm:"json,default:[\"a\"]"`)))
+	assert.NoError(t, checkDefault(field("decimal.Decimal", `lorm:"default:1.5"`)))
+	assert.NoError(t, checkDefault(field("string", `lorm:"default"`)))
+	assert.Error(t, checkDefault(field("int", `lorm:"default:abc"`)))
+	assert.Error(t, checkDefault(field("int8", `lorm:"default:300"`)))
+	assert.Error(t, checkDefault(field("*float64", `lorm:"default:x"`)))
+	assert.Error(t, checkDefault(field("[]string", `lorm:"json,default:[a"`)))
+
+	err := checkDefaults(&lorm.FileDescriptor{Path: "a.go", Structs: []*lorm.ModelDescriptor{
+		{Name: "User", Fields: []*lorm.FieldDescriptor{{Name: "Age", Type: "int", Flag: lorm.FlagDefault, Default: "abc"}}},
+	}})
+	assert.ErrorContains(t, err, `a.go: User.Age: invalid default "abc"`)
+}
+
+func Test_checkTimeFlags(t *testing.T) {
+	file := func(typ string, flag lorm.FieldFlag) *lorm.FileDescriptor {
+		return &lorm.FileDescriptor{Path: "a.go", Structs: []*lorm.ModelDescriptor{
+			{Name: "User", Fields: []*lorm.FieldDescriptor{{Name: "CreatedAt", Type: typ, Flag: lorm.FlagCreated | flag}}},
+		}}
+	}
+	assert.NoError(t, checkTimeFlags(file("int64", lorm.FlagUnixMilli)))
+	assert.NoError(t, checkTimeFlags(file("*uint64", lorm.FlagUnixNano)))
+	assert.NoError(t, checkTimeFlags(file("int32", 0)))
+	assert.EqualError(t, checkTimeFlags(file("int32", lorm.FlagUnixMilli)), "a.go: User.CreatedAt: unix_milli overflows int32, use int64 or uint64")
+	assert.EqualError(t, checkTimeFlags(file("*uint32", lorm.FlagUnixNano)), "a.go: User.CreatedAt: unix_nano overflows uint32, use int64 or uint64")
 }
@@ -0,0 +1,15 @@
+package common
+
+import "time"
+
+type BaseModel struct {
+	ID        int64     `lorm:"primary_key,auto_increment"`
+	CreatedAt time.Time `lorm:"created"`
+	UpdatedAt time.Time `lorm:"updated"`
+	version   int
+}
+
+type Audit[T any] struct {
+	CreatedBy T  `lorm:",created_by"`
+	UpdatedBy *T `lorm:",updated_by"`
+}
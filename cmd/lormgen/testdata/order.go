@@ -0,0 +1,19 @@
+package testdata
+
+import (
+	"github.com/yvvlee/lorm"
+	"github.com/yvvlee/lorm/cmd/lormgen/testdata/common"
+)
+
+type OrderMeta struct {
+	Note string
+	Tags []string `lorm:"json"`
+}
+
+type Order struct {
+	lorm.UnimplementedTable
+	common.BaseModel
+	common.Audit[int64]
+	*OrderMeta `lorm:"meta_"`
+	Amount     int
+}
@@ -8,9 +8,11 @@ import (
 
 type User struct {
 	lorm.UnimplementedTable `lorm:"users"`
-	ID                      int `lorm:"primary_key,auto_increment"`
-	Name                    string
-	Age                     int
-	CreatedAt               time.Time `lorm:"created"`
-	UpdatedAt               time.Time `lorm:"updated"`
+	ID                      int            `lorm:"primary_key,auto_increment"`
+	Name                    string         `lorm:",not_null,size:64"`
+	Age                     int            `lorm:"default:18" validate:"min:0,max:150"`
+	CreatedAt               time.Time      `lorm:"created"`
+	UpdatedAt               time.Time      `lorm:"updated"`
+	Addresses               []*UserAddress `lorm:"rel:has_many"`
+	Followers               []*User        `lorm:"rel:many_to_many,join:user_followers,assoc:follower_id"`
 }
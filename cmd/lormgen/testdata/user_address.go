@@ -15,6 +15,11 @@ type UserAddress struct {
 	Int64Alias Int64Alias `lorm:"int64_alias"`
 	Strings    Strings    `lorm:"strings,json"`
 	Address    `lorm:"addr_"`
+	Remark     *string
+	Nickname   lorm.Null[string]
+	Phone      string `lorm:"nullzero"`
+	UserID     int
+	User       *User `lorm:"rel:belongs_to"`
 }
 
 type Int64Alias int64
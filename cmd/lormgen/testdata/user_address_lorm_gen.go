@@ -8,6 +8,12 @@ import (
 	"github.com/yvvlee/lorm"
 )
 
+func init() {
+	lorm.Register(
+		new(UserAddress),
+	)
+}
+
 func (m *UserAddress) New() lorm.Model {
 	return new(UserAddress)
 }
@@ -19,7 +25,39 @@ func (m *UserAddress) LormFieldMap() map[string]any {
 		"strings":        &m.Strings,
 		"addr_address":   &m.Address.Address,
 		"addr_post_code": &m.Address.PostCode,
+		"remark":         &m.Remark,
+		"nickname":       &m.Nickname,
+		"phone":          &m.Phone,
+		"user_id":        &m.UserID,
+	}
+}
+
+func (m *UserAddress) LormScanDest(fields []int, dest []any) []any {
+	for _, i := range fields {
+		switch i {
+		case 0:
+			dest = append(dest, &m.ID)
+		case 1:
+			dest = append(dest, &m.Int64Alias)
+		case 2:
+			dest = append(dest, &m.Strings)
+		case 3:
+			dest = append(dest, &m.Address.Address)
+		case 4:
+			dest = append(dest, &m.Address.PostCode)
+		case 5:
+			dest = append(dest, &m.Remark)
+		case 6:
+			dest = append(dest, &m.Nickname)
+		case 7:
+			dest = append(dest, &m.Phone)
+		case 8:
+			dest = append(dest, &m.UserID)
+		default:
+			dest = append(dest, nil)
+		}
 	}
+	return dest
 }
 
 func (m *UserAddress) LormModelDescriptor() *lorm.ModelDescriptor {
@@ -68,6 +106,30 @@ func (f *UserAddress_Fields) PostCode() string {
 	}
 	return f.alias + ".addr_post_code"
 }
+func (f *UserAddress_Fields) Remark() string {
+	if f.alias == "" {
+		return "remark"
+	}
+	return f.alias + ".remark"
+}
+func (f *UserAddress_Fields) Nickname() string {
+	if f.alias == "" {
+		return "nickname"
+	}
+	return f.alias + ".nickname"
+}
+func (f *UserAddress_Fields) Phone() string {
+	if f.alias == "" {
+		return "phone"
+	}
+	return f.alias + ".phone"
+}
+func (f *UserAddress_Fields) UserID() string {
+	if f.alias == "" {
+		return "user_id"
+	}
+	return f.alias + ".user_id"
+}
 
 func (f *UserAddress_Fields) All() []string {
 	return []string{
@@ -76,10 +138,14 @@ func (f *UserAddress_Fields) All() []string {
 		f.Strings(),
 		f.Address(),
 		f.PostCode(),
+		f.Remark(),
+		f.Nickname(),
+		f.Phone(),
+		f.UserID(),
 	}
 }
 
-const _lorm_file_testdata_user_address_raw = `{"Path":"testdata/user_address.go","LormImportAlias":"lorm","Package":"testdata","Imports":[{"Path":"\"github.com/yvvlee/lorm\"","Alias":""}],"Structs":[{"Name":"UserAddress","TableName":"","Fields":[{"Name":"ID","FullName":"ID","DBField":"id","Type":"int","Flag":3},{"Name":"Int64Alias","FullName":"Int64Alias","DBField":"int64_alias","Type":"Int64Alias","Flag":0},{"Name":"Strings","FullName":"Strings","DBField":"strings","Type":"Strings","Flag":4},{"Name":"Address","FullName":"Address.Address","DBField":"addr_address","Type":"string","Flag":0},{"Name":"PostCode","FullName":"Address.PostCode","DBField":"addr_post_code","Type":"string","Flag":0}]}]}`
+const _lorm_file_testdata_user_address_raw = `{"Path":"testdata/user_address.go","LormImportAlias":"lorm","Package":"testdata","Imports":[{"Path":"\"github.com/yvvlee/lorm\"","Alias":""}],"Structs":[{"Name":"UserAddress","TableName":"","Fields":[{"Name":"ID","FullName":"ID","DBField":"id","Type":"int","Flag":3},{"Name":"Int64Alias","FullName":"Int64Alias","DBField":"int64_alias","Type":"Int64Alias","Flag":0},{"Name":"Strings","FullName":"Strings","DBField":"strings","Type":"Strings","Flag":4},{"Name":"Address","FullName":"Address.Address","DBField":"addr_address","Type":"string","Flag":0},{"Name":"PostCode","FullName":"Address.PostCode","DBField":"addr_post_code","Type":"string","Flag":0},{"Name":"Remark","FullName":"Remark","DBField":"remark","Type":"*string","Flag":2048},{"Name":"Nickname","FullName":"Nickname","DBField":"nickname","Type":"lorm.Null[string]","Flag":2048},{"Name":"Phone","FullName":"Phone","DBField":"phone","Type":"string","Flag":4096},{"Name":"UserID","FullName":"UserID","DBField":"user_id","Type":"int","Flag":0}],"Relations":[{"Name":"User","Kind":"belongs_to","Type":"*User","ForeignKey":"user_id"}]}]}`
 
 var _lorm_file_testdata_user_address_model_descriptor_map = func() map[string]*lorm.ModelDescriptor {
 	var file lorm.FileDescriptor
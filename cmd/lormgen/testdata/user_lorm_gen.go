@@ -8,6 +8,12 @@ import (
 	"github.com/yvvlee/lorm"
 )
 
+func init() {
+	lorm.Register(
+		new(User),
+	)
+}
+
 func (m *User) TableName() string {
 	return "users"
 }
@@ -26,6 +32,26 @@ func (m *User) LormFieldMap() map[string]any {
 	}
 }
 
+func (m *User) LormScanDest(fields []int, dest []any) []any {
+	for _, i := range fields {
+		switch i {
+		case 0:
+			dest = append(dest, &m.ID)
+		case 1:
+			dest = append(dest, &m.Name)
+		case 2:
+			dest = append(dest, &m.Age)
+		case 3:
+			dest = append(dest, &m.CreatedAt)
+		case 4:
+			dest = append(dest, &m.UpdatedAt)
+		default:
+			dest = append(dest, nil)
+		}
+	}
+	return dest
+}
+
 func (m *User) LormModelDescriptor() *lorm.ModelDescriptor {
 	return _lorm_file_testdata_user_model_descriptor_map["User"]
 }
@@ -83,7 +109,7 @@ func (f *User_Fields) All() []string {
 	}
 }
 
-const _lorm_file_testdata_user_raw = `{"Path":"testdata/user.go","LormImportAlias":"lorm","Package":"testdata","Imports":[{"Path":"\"time\"","Alias":""},{"Path":"\"github.com/yvvlee/lorm\"","Alias":""}],"Structs":[{"Name":"User","TableName":"users","Fields":[{"Name":"ID","FullName":"ID","DBField":"id","Type":"int","Flag":3},{"Name":"Name","FullName":"Name","DBField":"name","Type":"string","Flag":0},{"Name":"Age","FullName":"Age","DBField":"age","Type":"int","Flag":0},{"Name":"CreatedAt","FullName":"CreatedAt","DBField":"created_at","Type":"time.Time","Flag":8},{"Name":"UpdatedAt","FullName":"UpdatedAt","DBField":"updated_at","Type":"time.Time","Flag":16}]}]}`
+const _lorm_file_testdata_user_raw = `{"Path":"testdata/user.go","LormImportAlias":"lorm","Package":"testdata","Imports":[{"Path":"\"time\"","Alias":""},{"Path":"\"github.com/yvvlee/lorm\"","Alias":""}],"Structs":[{"Name":"User","TableName":"users","Fields":[{"Name":"ID","FullName":"ID","DBField":"id","Type":"int","Flag":3},{"Name":"Name","FullName":"Name","DBField":"name","Type":"string","Flag":0,"Rules":[{"Name":"not_null"},{"Name":"size","Param":"64"}]},{"Name":"Age","FullName":"Age","DBField":"age","Type":"int","Flag":64,"Rules":[{"Name":"min","Param":"0"},{"Name":"max","Param":"150"}],"Default":"18"},{"Name":"CreatedAt","FullName":"CreatedAt","DBField":"created_at","Type":"time.Time","Flag":8},{"Name":"UpdatedAt","FullName":"UpdatedAt","DBField":"updated_at","Type":"time.Time","Flag":16}],"Relations":[{"Name":"Addresses","Kind":"has_many","Type":"[]*UserAddress","ForeignKey":"user_id"},{"Name":"Followers","Kind":"many_to_many","Type":"[]*User","ForeignKey":"user_id","JoinTable":"user_followers","AssociationKey":"follower_id"}]}]}`
 
 var _lorm_file_testdata_user_model_descriptor_map = func() map[string]*lorm.ModelDescriptor {
 	var file lorm.FileDescriptor
@@ -0,0 +1,179 @@
+package lorm
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/csv"
+	"encoding/gob"
+	stdjson "encoding/json"
+	"fmt"
+	"reflect"
+
+	json "github.com/bytedance/sonic"
+)
+
+// Codec encodes field values into column values and decodes them back, see WithCodec
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// codecFlags maps the field flags to the names of their codecs
+var codecFlags = []struct {
+	flag FieldFlag
+	name string
+}{
+	{FlagJson, "json"},
+	{FlagGob, "gob"},
+	{FlagMsgpack, "msgpack"},
+	{FlagCsv, "csv"},
+}
+
+// defaultCodecs are the codecs used when the engine has no codec registered by the name,
+// there is no default msgpack codec, it must be registered with WithCodec
+var defaultCodecs = map[string]Codec{
+	"json": SonicJSONCodec{},
+	"gob":  GobCodec{},
+	"csv":  CSVCodec{},
+}
+
+// fieldCodec returns the codec name of the field flag
+func fieldCodec(flag FieldFlag) (string, bool) {
+	for _, item := range codecFlags {
+		if flag.HasFlag(item.flag) {
+			return item.name, true
+		}
+	}
+	return "", false
+}
+
+// SonicJSONCodec encodes values as JSON with bytedance/sonic, it is the default json codec
+type SonicJSONCodec struct{}
+
+func (SonicJSONCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (SonicJSONCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+// StdJSONCodec encodes values as JSON with encoding/json
+type StdJSONCodec struct{}
+
+func (StdJSONCodec) Marshal(v any) ([]byte, error) {
+	return stdjson.Marshal(v)
+}
+
+func (StdJSONCodec) Unmarshal(data []byte, v any) error {
+	return stdjson.Unmarshal(data, v)
+}
+
+// GobCodec encodes values with encoding/gob, the column must be able to store binary data
+type GobCodec struct{}
+
+func (GobCodec) Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (GobCodec) Unmarshal(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// CSVCodec encodes a string slice as a single comma-joined CSV record, eg: []string{"a", "b"} is stored as "a,b"
+type CSVCodec struct{}
+
+func (CSVCodec) Marshal(v any) ([]byte, error) {
+	var record []string
+	switch v := v.(type) {
+	case []string:
+		record = v
+	case *[]string:
+		record = *v
+	default:
+		return nil, fmt.Errorf("lorm: csv codec expects []string, got %T", v)
+	}
+	if len(record) == 0 {
+		return []byte{}, nil
+	}
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(record); err != nil {
+		return nil, err
+	}
+	w.Flush()
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), w.Error()
+}
+
+func (CSVCodec) Unmarshal(data []byte, v any) error {
+	record, ok := v.(*[]string)
+	if !ok {
+		return fmt.Errorf("lorm: csv codec expects *[]string, got %T", v)
+	}
+	if len(data) == 0 {
+		*record = []string{}
+		return nil
+	}
+	values, err := csv.NewReader(bytes.NewReader(data)).Read()
+	if err != nil {
+		return err
+	}
+	*record = values
+	return nil
+}
+
+// missingCodec is returned for codecs which are neither registered nor built in
+type missingCodec string
+
+func (c missingCodec) Marshal(any) ([]byte, error) {
+	return nil, c.err()
+}
+
+func (c missingCodec) Unmarshal([]byte, any) error {
+	return c.err()
+}
+
+func (c missingCodec) err() error {
+	return fmt.Errorf("lorm: codec %q is not registered, see WithCodec", string(c))
+}
+
+// CodecFieldWrapper encodes the value of a field with a Codec when it is written and decodes it when it is scanned,
+// a nil pointer field is stored as NULL and NULL is scanned as a nil pointer
+type CodecFieldWrapper struct {
+	v     any
+	codec Codec
+}
+
+func NewCodecFieldWrapper(v any, codec Codec) *CodecFieldWrapper {
+	return &CodecFieldWrapper{v: v, codec: codec}
+}
+
+func (s *CodecFieldWrapper) Value() (driver.Value, error) {
+	if s.v == nil || isNilPointer(s.v) {
+		return nil, nil
+	}
+	return s.codec.Marshal(s.v)
+}
+
+func (s *CodecFieldWrapper) Scan(src any) error {
+	if v, ok := s.v.(sql.Scanner); ok {
+		return v.Scan(src)
+	}
+	switch v := src.(type) {
+	case []byte:
+		return s.codec.Unmarshal(v, s.v)
+	case string:
+		return s.codec.Unmarshal([]byte(v), s.v)
+	case nil:
+		if value := reflect.ValueOf(s.v); value.Kind() == reflect.Pointer && value.Elem().Kind() == reflect.Pointer {
+			value.Elem().SetZero()
+		}
+		return nil
+	}
+	return fmt.Errorf("cannot decode %v into %T", src, s.v)
+}
@@ -0,0 +1,102 @@
+package lorm
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// upperJSONCodec records the calls to prove a registered codec replaces the built-in one
+type upperJSONCodec struct {
+	StdJSONCodec
+	calls *int
+}
+
+func (c upperJSONCodec) Marshal(v any) ([]byte, error) {
+	*c.calls++
+	return c.StdJSONCodec.Marshal(v)
+}
+
+func (c upperJSONCodec) Unmarshal(data []byte, v any) error {
+	*c.calls++
+	return c.StdJSONCodec.Unmarshal(data, v)
+}
+
+func TestCSVCodec(t *testing.T) {
+	data, err := CSVCodec{}.Marshal([]string{"a", "b,c", `d"e`})
+	assert.NoError(t, err)
+	assert.Equal(t, `a,"b,c","d""e"`, string(data))
+	var values []string
+	assert.NoError(t, CSVCodec{}.Unmarshal(data, &values))
+	assert.Equal(t, []string{"a", "b,c", `d"e`}, values)
+
+	assert.NoError(t, CSVCodec{}.Unmarshal(nil, &values))
+	assert.Empty(t, values)
+	_, err = CSVCodec{}.Marshal(1)
+	assert.Error(t, err)
+}
+
+func TestCodecFields(t *testing.T) {
+	engine := newSQLiteEngine(t, "CREATE TABLE codec_model (id INTEGER PRIMARY KEY, tags TEXT, attrs BLOB, extra TEXT, blob BLOB)")
+	var calls int
+	WithCodec("json", upperJSONCodec{calls: &calls})(engine.config)
+	ctx := context.TODO()
+
+	m := &codecModel{ID: 1, Tags: []string{"a", "b"}, Attrs: map[string]int{"x": 1}, Extra: Sub{ID: 2, Name: "n"}}
+	_, err := Insert(ctx, engine, m)
+	assert.ErrorContains(t, err, `lorm: codec "msgpack" is not registered, see WithCodec`)
+
+	calls = 0
+	WithCodec("msgpack", StdJSONCodec{})(engine.config)
+	_, err = Insert(ctx, engine, m)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	tags, _, err := QueryCol[string](engine).From("codec_model").Columns("tags").Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "a,b", tags)
+
+	found, err := Query[*codecModel](engine).Where("id = ?", 1).Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, m.Tags, found.Tags)
+	assert.Equal(t, m.Attrs, found.Attrs)
+	assert.Equal(t, m.Extra, found.Extra)
+
+	var extra Sub
+	assert.NoError(t, NewCodecFieldWrapper(&extra, StdJSONCodec{}).Scan(`{"id":3}`))
+	assert.Equal(t, 3, extra.ID)
+	raw, _ := stdjson.Marshal(extra)
+	value, err := NewCodecFieldWrapper(extra, StdJSONCodec{}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, raw, value)
+}
+
+func TestJSONCodecOfEngine(t *testing.T) {
+	engine := newSQLiteEngine(t,
+		"CREATE TABLE secret_model (id INTEGER PRIMARY KEY, email TEXT, phone TEXT, score TEXT, labels TEXT)",
+		"CREATE TABLE json_map (id INTEGER PRIMARY KEY, attrs JSON)",
+	)
+	var calls int
+	WithCodec("json", upperJSONCodec{calls: &calls})(engine.config)
+	WithKeyProvider(&KeyRing{Current: "k1", Keys: map[string][]byte{"k1": []byte("0123456789abcdef")}})(engine.config)
+	ctx := context.TODO()
+
+	// encrypted fields encode values other than strings with the json codec, labels is also a json field
+	_, err := Insert(ctx, engine, &secretModel{ID: 1, Email: "a", Score: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	found, err := Query[*secretModel](engine).Where("id = ?", 1).Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, found.Score)
+	assert.Equal(t, 4, calls)
+
+	_, err = engine.Exec(ctx, `INSERT INTO json_map VALUES (1, '{"a":1}')`)
+	assert.NoError(t, err)
+	calls = 0
+	maps, err := QueryMaps(engine).From("json_map").Columns("attrs").Find(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"attrs": map[string]any{"a": float64(1)}}}, maps)
+	assert.Equal(t, 1, calls)
+}
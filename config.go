@@ -1,6 +1,9 @@
 package lorm
 
 import (
+	"context"
+	"database/sql/driver"
+	"reflect"
 	"time"
 
 	"github.com/yvvlee/lorm/builder"
@@ -26,6 +29,20 @@ type Config struct {
 	connMaxLifetime time.Duration
 	// connMaxIdleTime is the maximum amount of time a connection may be idle
 	connMaxIdleTime time.Duration
+	// keyProvider provides the keys of encrypted fields
+	keyProvider KeyProvider
+	// codecs stores the codecs of encoded fields by name, eg: "json", "gob", "msgpack", "csv"
+	codecs map[string]Codec
+	// converters stores the converters of custom column types by Go type
+	converters map[reflect.Type]Converter
+	// clock returns the current time of created and updated fields, time.Now is used if it is nil
+	clock func() time.Time
+	// location is the location created and updated times are converted to, the times are left as is if it is nil
+	location *time.Location
+	// auditor returns the current user of created_by and updated_by fields
+	auditor func(ctx context.Context) any
+	// strictColumns makes model scanners fail when the result columns do not match the fields of the model
+	strictColumns bool
 }
 
 type Option func(*Config)
@@ -78,3 +95,68 @@ func WithLogger(logger Logger) Option {
 		c.logger = logger
 	}
 }
+
+// WithKeyProvider sets the key provider of encrypted fields
+func WithKeyProvider(keyProvider KeyProvider) Option {
+	return func(c *Config) {
+		c.keyProvider = keyProvider
+	}
+}
+
+// WithCodec registers the codec of encoded fields by name, it replaces the built-in codec of the same name,
+// eg: WithCodec("json", lorm.StdJSONCodec{}) to use encoding/json instead of sonic
+func WithCodec(name string, codec Codec) Option {
+	return func(c *Config) {
+		if c.codecs == nil {
+			c.codecs = make(map[string]Codec)
+		}
+		c.codecs[name] = codec
+	}
+}
+
+// WithConverter registers the converter of typ, it is used to bind and scan fields and columns of typ and *typ
+func WithConverter(typ reflect.Type, converter Converter) Option {
+	return func(c *Config) {
+		if c.converters == nil {
+			c.converters = make(map[reflect.Type]Converter)
+		}
+		c.converters[typ] = converter
+	}
+}
+
+// WithTypeConverter registers the converter of T built from a pair of functions
+func WithTypeConverter[T any](toDB func(T) (driver.Value, error), fromDB func(src any) (T, error)) Option {
+	return WithConverter(reflect.TypeFor[T](), TypeConverter[T]{ToDB: toDB, FromDB: fromDB})
+}
+
+// WithClock sets the clock of created and updated fields, eg: a fixed time in tests
+func WithClock(clock func() time.Time) Option {
+	return func(c *Config) {
+		c.clock = clock
+	}
+}
+
+// WithLocation converts created and updated times to loc before they are written and parses the time defaults of tags in it,
+// eg: WithLocation(time.UTC)
+func WithLocation(loc *time.Location) Option {
+	return func(c *Config) {
+		c.location = loc
+	}
+}
+
+// WithAuditor sets the function returning the current user from ctx, eg: the user id set by a middleware,
+// its result fills the zero created_by and updated_by fields on insert and replaces updated_by on update,
+// returning nil leaves them unchanged
+func WithAuditor(auditor func(ctx context.Context) any) Option {
+	return func(c *Config) {
+		c.auditor = auditor
+	}
+}
+
+// WithStrictColumns makes queries of models fail with a *ColumnMismatchError when the result has columns without a field
+// or misses fields of the model, see QueryModelStmt.Strict to exclude fields of a query
+func WithStrictColumns() Option {
+	return func(c *Config) {
+		c.strictColumns = true
+	}
+}
@@ -0,0 +1,155 @@
+package lorm
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"fmt"
+	"reflect"
+)
+
+// Converter maps a Go type to column values, it is used for types which can't implement driver.Valuer and sql.Scanner,
+// eg: types from other packages or enums, see WithConverter
+type Converter interface {
+	// Valuer returns the driver.Valuer of the value pointed by ptr
+	Valuer(ptr any) driver.Valuer
+	// Scanner returns the sql.Scanner storing scanned values into ptr
+	Scanner(ptr any) sql.Scanner
+}
+
+// TypeConverter is a Converter of T built from a pair of functions
+type TypeConverter[T any] struct {
+	ToDB   func(T) (driver.Value, error)
+	FromDB func(src any) (T, error)
+}
+
+func (c TypeConverter[T]) Valuer(ptr any) driver.Valuer {
+	return valuerFunc(func() (driver.Value, error) {
+		return c.ToDB(*ptr.(*T))
+	})
+}
+
+func (c TypeConverter[T]) Scanner(ptr any) sql.Scanner {
+	return scannerFunc(func(src any) error {
+		v, err := c.FromDB(src)
+		if err != nil {
+			return err
+		}
+		*ptr.(*T) = v
+		return nil
+	})
+}
+
+// TextConverter returns a Converter storing T as text through encoding.TextMarshaler and encoding.TextUnmarshaler,
+// eg: TextConverter[netip.Addr]()
+func TextConverter[T any, P interface {
+	*T
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+}]() Converter {
+	return TypeConverter[T]{
+		ToDB: func(v T) (driver.Value, error) {
+			text, err := P(&v).MarshalText()
+			if err != nil {
+				return nil, err
+			}
+			return string(text), nil
+		},
+		FromDB: func(src any) (T, error) {
+			var v T
+			switch src := src.(type) {
+			case nil:
+				return v, nil
+			case []byte:
+				return v, P(&v).UnmarshalText(src)
+			case string:
+				return v, P(&v).UnmarshalText([]byte(src))
+			}
+			return v, fmt.Errorf("lorm: cannot convert %T to %T", src, v)
+		},
+	}
+}
+
+type valuerFunc func() (driver.Value, error)
+
+func (f valuerFunc) Value() (driver.Value, error) {
+	return f()
+}
+
+type scannerFunc func(src any) error
+
+func (f scannerFunc) Scan(src any) error {
+	return f(src)
+}
+
+// ConverterFieldWrapper binds and scans a field through a Converter
+type ConverterFieldWrapper struct {
+	driver.Valuer
+	sql.Scanner
+}
+
+func NewConverterFieldWrapper(ptr any, converter Converter) *ConverterFieldWrapper {
+	return &ConverterFieldWrapper{
+		Valuer:  converter.Valuer(ptr),
+		Scanner: converter.Scanner(ptr),
+	}
+}
+
+// nullableConverter applies the converter of T to fields of type *T, nil pointers are NULL
+type nullableConverter struct {
+	converter Converter
+}
+
+func (c nullableConverter) Valuer(ptr any) driver.Valuer {
+	return valuerFunc(func() (driver.Value, error) {
+		value := reflect.ValueOf(ptr).Elem()
+		if value.IsNil() {
+			return nil, nil
+		}
+		return c.converter.Valuer(value.Interface()).Value()
+	})
+}
+
+func (c nullableConverter) Scanner(ptr any) sql.Scanner {
+	return scannerFunc(func(src any) error {
+		value := reflect.ValueOf(ptr).Elem()
+		if src == nil {
+			value.SetZero()
+			return nil
+		}
+		elem := reflect.New(value.Type().Elem())
+		if err := c.converter.Scanner(elem.Interface()).Scan(src); err != nil {
+			return err
+		}
+		value.Set(elem)
+		return nil
+	})
+}
+
+// converter returns the converter registered for typ, converters of T also apply to *T
+func (e *Engine) converter(typ reflect.Type) (Converter, bool) {
+	if e == nil || e.config == nil || len(e.config.converters) == 0 {
+		return nil, false
+	}
+	if converter, ok := e.config.converters[typ]; ok {
+		return converter, true
+	}
+	if typ.Kind() == reflect.Pointer {
+		if converter, ok := e.config.converters[typ.Elem()]; ok {
+			return nullableConverter{converter: converter}, true
+		}
+	}
+	return nil, false
+}
+
+// convert wraps ptr with the converter registered for the type it points to
+func (e *Engine) convert(ptr any) any {
+	typ := reflect.TypeOf(ptr)
+	if typ == nil || typ.Kind() != reflect.Pointer {
+		return ptr
+	}
+	if converter, ok := e.converter(typ.Elem()); ok {
+		return NewConverterFieldWrapper(ptr, converter)
+	}
+	return ptr
+}
@@ -0,0 +1,105 @@
+package lorm
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type level int
+
+const (
+	levelLow level = iota + 1
+	levelHigh
+)
+
+var levelNames = map[level]string{levelLow: "low", levelHigh: "high"}
+
+func newConverterEngine(t *testing.T) *Engine {
+	engine := newSQLiteEngine(t,
+		"CREATE TABLE converter_model (id INTEGER PRIMARY KEY, addr TEXT, gateway TEXT, level TEXT)",
+		"CREATE TABLE converter_key_model (addr TEXT PRIMARY KEY, level TEXT)",
+	)
+	WithConverter(reflect.TypeFor[netip.Addr](), TextConverter[netip.Addr]())(engine.config)
+	WithTypeConverter(func(v level) (driver.Value, error) {
+		return levelNames[v], nil
+	}, func(src any) (level, error) {
+		var name string
+		switch src := src.(type) {
+		case string:
+			name = src
+		case []byte:
+			name = string(src)
+		}
+		for k, v := range levelNames {
+			if v == name {
+				return k, nil
+			}
+		}
+		return 0, fmt.Errorf("unknown level %v", src)
+	})(engine.config)
+	return engine
+}
+
+func TestConverters(t *testing.T) {
+	engine := newConverterEngine(t)
+	ctx := context.TODO()
+
+	m := &converterModel{ID: 1, Addr: netip.MustParseAddr("10.0.0.1"), Level: levelHigh}
+	_, err := Insert(ctx, engine, m)
+	assert.NoError(t, err)
+
+	levels, err := QueryCol[string](engine).From("converter_model").Columns("level").Find(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"high"}, levels)
+
+	found, err := Query[*converterModel](engine).Where("id = ?", 1).Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, m.Addr, found.Addr)
+	assert.Nil(t, found.Gateway)
+	assert.Equal(t, levelHigh, found.Level)
+
+	gateway := netip.MustParseAddr("10.0.0.254")
+	found.Gateway = &gateway
+	_, err = Update(engine).SetModel(found).Exec(ctx)
+	assert.NoError(t, err)
+
+	gateways, err := QueryCol[*netip.Addr](engine).From("converter_model").Columns("gateway").Find(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []*netip.Addr{&gateway}, gateways)
+	addr, ok, err := QueryCol[netip.Addr](engine).From("converter_model").Columns("addr").Get(ctx)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, m.Addr, addr)
+}
+
+func TestConverterPrimaryKeys(t *testing.T) {
+	engine := newConverterEngine(t)
+	ctx := context.TODO()
+
+	m := &converterKeyModel{Addr: netip.MustParseAddr("10.0.0.1"), Level: levelLow}
+	_, values, err := ModelToInsertData(engine, m)
+	assert.NoError(t, err)
+	value, err := values[0].(driver.Valuer).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", value)
+
+	_, err = Insert(ctx, engine, m)
+	assert.NoError(t, err)
+	m.Level = levelHigh
+	affected, err := Update(engine).SetModel(m).Exec(ctx)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, affected)
+	levels, err := QueryCol[string](engine).From("converter_key_model").Columns("level").Find(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"high"}, levels)
+
+	affected, err = Delete(engine).Model(m).Exec(ctx)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, affected)
+}
@@ -0,0 +1,127 @@
+package lorm
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cast"
+	"github.com/yvvlee/lorm/builder"
+)
+
+// dbDefault is bound instead of zero values of fields declared with `lorm:"default"`,
+// it is a comparable value so that the columns bound to it in every row can be omitted
+var dbDefault builder.Sqlizer = dbDefaultExpr{}
+
+type dbDefaultExpr struct{}
+
+func (dbDefaultExpr) ToSql() (string, []any, error) {
+	return "DEFAULT", nil, nil
+}
+
+var scannerType = reflect.TypeFor[sql.Scanner]()
+
+// applyDefault fills the zero value pointed by ptr with the default value of field,
+// it returns the DEFAULT keyword to bind instead of ptr for database-side defaults,
+// and an error if the default can not be converted to the type of the field
+func (e *Engine) applyDefault(ptr any, field *FieldDescriptor) (any, bool, error) {
+	value := reflect.ValueOf(ptr).Elem()
+	if !value.IsZero() {
+		return nil, false, nil
+	}
+	if field.Default == "" {
+		return dbDefault, true, nil
+	}
+	if name, ok := fieldCodec(field.Flag); ok {
+		if err := e.codec(name).Unmarshal([]byte(field.Default), ptr); err != nil {
+			return nil, false, fmt.Errorf("lorm: invalid default %q of field %s: %w", field.Default, field.DBField, err)
+		}
+		return nil, false, nil
+	}
+	typ := value.Type()
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	v, err := parseDefault(typ, field.Default, e.location())
+	if err != nil {
+		return nil, false, fmt.Errorf("lorm: invalid default %q of field %s: %w", field.Default, field.DBField, err)
+	}
+	if value.Kind() == reflect.Pointer {
+		ptrValue := reflect.New(typ)
+		ptrValue.Elem().Set(v)
+		v = ptrValue
+	}
+	value.Set(v)
+	return nil, false, nil
+}
+
+// omitDBDefaults removes the columns bound to the DEFAULT keyword in every row,
+// so that databases without DEFAULT in VALUES (eg: sqlite) apply the column default
+func omitDBDefaults(columns []string, values [][]any) ([]string, [][]any) {
+	omit := make([]bool, len(columns))
+	omitted := 0
+	for i := range columns {
+		omit[i] = lo.EveryBy(values, func(row []any) bool {
+			return row[i] == dbDefault
+		})
+		if omit[i] {
+			omitted++
+		}
+	}
+	if omitted == 0 || omitted == len(columns) {
+		return columns, values
+	}
+	keep := func(_ any, i int) bool { return !omit[i] }
+	columns = lo.Filter(columns, func(column string, i int) bool { return !omit[i] })
+	for i, row := range values {
+		values[i] = lo.Filter(row, keep)
+	}
+	return columns, values
+}
+
+// parseDefault converts the default value declared in the tag to typ, times without a zone are parsed in loc
+func parseDefault(typ reflect.Type, s string, loc *time.Location) (reflect.Value, error) {
+	v := reflect.New(typ).Elem()
+	if reflect.PointerTo(typ).Implements(scannerType) {
+		return v, v.Addr().Interface().(sql.Scanner).Scan(s)
+	}
+	if typ == reflect.TypeFor[time.Time]() {
+		t, err := cast.ToTimeInDefaultLocationE(s, loc)
+		v.Set(reflect.ValueOf(t))
+		return v, err
+	}
+	switch typ.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return v, err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, typ.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, typ.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, typ.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetFloat(f)
+	default:
+		return v, strconv.ErrSyntax
+	}
+	return v, nil
+}
@@ -0,0 +1,87 @@
+package lorm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+// brokenDefaultModel declares a default which can not be converted to its field,
+// lormgen rejects it so the model is written by hand
+type brokenDefaultModel struct {
+	UnimplementedTable
+	ID     int64
+	Broken int
+}
+
+func (m *brokenDefaultModel) TableName() string { return "broken_default_model" }
+func (m *brokenDefaultModel) New() Model        { return new(brokenDefaultModel) }
+func (m *brokenDefaultModel) LormFieldMap() map[string]any {
+	return map[string]any{"id": &m.ID, "broken": &m.Broken}
+}
+func (m *brokenDefaultModel) LormModelDescriptor() *ModelDescriptor {
+	return &ModelDescriptor{Name: "brokenDefaultModel", TableName: "broken_default_model", Fields: []*FieldDescriptor{
+		{DBField: "id", Flag: FlagPrimaryKey},
+		{DBField: "broken", Flag: FlagDefault, Default: "abc"},
+	}}
+}
+
+func TestModelsToInsertDataDefaults(t *testing.T) {
+	m := &defaultModel{ID: 1}
+	columns, values, err := ModelToInsertData(nil, m)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"id", "age", "nick", "score", "tags", "birthday"}, columns)
+	assert.Len(t, values, len(columns))
+	assert.Equal(t, 18, m.Age)
+	assert.Equal(t, lo.ToPtr("anonymous"), m.Nick)
+	assert.Equal(t, "1.5", m.Score.String())
+	assert.Equal(t, []string{"a"}, m.Tags)
+	assert.Equal(t, time.Date(2000, 1, 2, 3, 4, 5, 0, time.Local), m.Birthday)
+
+	models := []*defaultModel{{ID: 2, Age: 30, Status: "active"}, {ID: 3}}
+	columns, rows, err := ModelsToInsertData(nil, models)
+	assert.NoError(t, err)
+	assert.Equal(t, "status", columns[6])
+	assert.Equal(t, 30, models[0].Age)
+	assert.Equal(t, &models[0].Status, rows[0][6])
+	assert.Equal(t, dbDefault, rows[1][6])
+
+	_, _, err = ModelToInsertData(nil, &brokenDefaultModel{ID: 4})
+	assert.ErrorContains(t, err, `invalid default "abc" of field broken`)
+
+	// time defaults are parsed in the location of the engine
+	loc := time.FixedZone("UTC+8", 8*3600)
+	engine := &Engine{config: &Config{location: loc}}
+	m = &defaultModel{ID: 5}
+	_, _, err = ModelToInsertData(engine, m)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2000, 1, 2, 3, 4, 5, 0, loc), m.Birthday)
+}
+
+func TestInsertDefaults(t *testing.T) {
+	engine := newSQLiteEngine(t,
+		"CREATE TABLE default_model (id INTEGER PRIMARY KEY, age INTEGER, nick TEXT, score TEXT, tags TEXT, birthday DATETIME, status TEXT DEFAULT 'new')",
+		"CREATE TABLE broken_default_model (id INTEGER PRIMARY KEY, broken INTEGER)",
+	)
+	ctx := context.TODO()
+	_, err := Insert(ctx, engine, &defaultModel{ID: 2, Status: "active"})
+	assert.NoError(t, err)
+	m, err := Query[*defaultModel](engine).Where("id = ?", 2).Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 18, m.Age)
+	assert.Equal(t, "anonymous", *m.Nick)
+	assert.Equal(t, []string{"a"}, m.Tags)
+	assert.Equal(t, "active", m.Status)
+
+	_, err = Insert(ctx, engine, &defaultModel{ID: 3})
+	assert.NoError(t, err)
+	m, err = Query[*defaultModel](engine).Where("id = ?", 3).Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "new", m.Status)
+
+	_, err = Insert(ctx, engine, &brokenDefaultModel{ID: 4})
+	assert.ErrorContains(t, err, "invalid default")
+}
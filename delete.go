@@ -2,10 +2,16 @@ package lorm
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"github.com/samber/lo"
 	"github.com/yvvlee/lorm/builder"
 )
 
+// ErrMissingPrimaryKey is returned when a model is deleted by its primary keys but has none or a zero one
+var ErrMissingPrimaryKey = errors.New("lorm: model has no primary key value")
+
 func Delete(engine *Engine) *DeleteStmt {
 	return &DeleteStmt{
 		engine:  engine,
@@ -16,9 +22,19 @@ func Delete(engine *Engine) *DeleteStmt {
 type DeleteStmt struct {
 	engine  *Engine
 	builder *builder.DeleteBuilder
+	// model is the model set by Model, its hooks are called by Exec
+	model Table
+	// err is returned by Exec, eg: the model set by Model has no primary key value
+	err error
 }
 
 func (s *DeleteStmt) Exec(ctx context.Context) (rowsAffected int64, err error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	if err = callHooks(ctx, lo.Compact([]Table{s.model}), BeforeDeleteHook.BeforeDelete); err != nil {
+		return 0, err
+	}
 	query, args, err := s.builder.ToSql()
 	if err != nil {
 		return 0, err
@@ -35,6 +51,20 @@ func (s *DeleteStmt) From(from string) *DeleteStmt {
 	return s
 }
 
+// Model deletes the row of model by its primary keys, the BeforeDelete hook of model is called by Exec,
+// Exec returns ErrMissingPrimaryKey without deleting anything if the model has no primary key or a zero one
+func (s *DeleteStmt) Model(model Table) *DeleteStmt {
+	s.model = model
+	escaper := s.engine.Escaper()
+	s.builder.From(escaper.Escape(model.TableName()))
+	if !hasPrimaryKey(model) {
+		s.err = fmt.Errorf("%w: %s", ErrMissingPrimaryKey, model.LormModelDescriptor().Name)
+		return s
+	}
+	s.builder.Where(s.engine.primaryKeyEq(model))
+	return s
+}
+
 // Prefix adds an expression to the beginning of the query
 func (s *DeleteStmt) Prefix(sql string, args ...any) *DeleteStmt {
 	s.builder.Prefix(sql, args...)
@@ -0,0 +1,30 @@
+package lorm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteModelWithoutPrimaryKey(t *testing.T) {
+	engine := newSQLiteEngine(t,
+		"CREATE TABLE event_log (name TEXT)",
+		"INSERT INTO event_log VALUES ('a'), ('b'), ('c')",
+		"CREATE TABLE hook_model (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL)",
+		"INSERT INTO hook_model VALUES (1, 'a'), (2, 'b')",
+	)
+	ctx := context.TODO()
+
+	_, err := NewRepository[*eventLog](engine).DeleteModel(ctx, &eventLog{Name: "a"})
+	assert.ErrorIs(t, err, ErrMissingPrimaryKey)
+	count, err := Query[*eventLog](engine).Count(ctx)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, count)
+
+	_, err = Delete(engine).Model(&hookModel{Name: "a"}).Exec(ctx)
+	assert.EqualError(t, err, "lorm: model has no primary key value: hookModel")
+	count, err = Query[*hookModel](engine).Count(ctx)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+}
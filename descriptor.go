@@ -1,13 +1,14 @@
 package lorm
 
 import (
+	"strconv"
 	"strings"
 
 	json "github.com/bytedance/sonic"
 	"github.com/samber/lo"
 )
 
-type FieldFlag uint8
+type FieldFlag uint32
 
 func (f FieldFlag) HasFlag(flag FieldFlag) bool {
 	return f&flag != 0
@@ -20,6 +21,31 @@ const (
 	FlagCreated
 	FlagUpdated
 	FlagVersion
+	// FlagDefault marks a field with a default value, eg: `lorm:"default:18"`,
+	// a field declared with `lorm:"default"` uses the database-side default
+	FlagDefault
+	// FlagEncrypted encrypts the field with the KeyProvider of the engine, see EncryptedFieldWrapper
+	FlagEncrypted
+	// FlagGob encodes the field with the gob codec
+	FlagGob
+	// FlagMsgpack encodes the field with the msgpack codec, which must be registered with WithCodec
+	FlagMsgpack
+	// FlagCsv stores a string slice as a comma-joined string
+	FlagCsv
+	// FlagNullable marks a field which can store NULL, lormgen sets it on pointer and Null[T] fields,
+	// keyset pagination uses it to reject NULL sort keys
+	FlagNullable
+	// FlagNullZero stores the zero value of the field as NULL and scans NULL as the zero value, see NullZeroFieldWrapper
+	FlagNullZero
+	// FlagUnixMilli stores created and updated times of 64-bit integer fields as Unix milliseconds,
+	// time.Time fields are truncated to milliseconds
+	FlagUnixMilli
+	// FlagUnixNano stores created and updated times of 64-bit integer fields as Unix nanoseconds
+	FlagUnixNano
+	// FlagCreatedBy fills a zero field with the value returned by the auditor of the engine on insert, see WithAuditor
+	FlagCreatedBy
+	// FlagUpdatedBy fills a zero field with the value returned by the auditor of the engine on insert, and overwrites it on update
+	FlagUpdatedBy
 )
 
 var FlagTagMap = map[FieldFlag]string{
@@ -29,6 +55,17 @@ var FlagTagMap = map[FieldFlag]string{
 	FlagCreated:       "created",
 	FlagUpdated:       "updated",
 	FlagVersion:       "version",
+	FlagDefault:       "default",
+	FlagEncrypted:     "encrypted",
+	FlagGob:           "gob",
+	FlagMsgpack:       "msgpack",
+	FlagCsv:           "csv",
+	FlagNullable:      "nullable",
+	FlagNullZero:      "nullzero",
+	FlagUnixMilli:     "unix_milli",
+	FlagUnixNano:      "unix_nano",
+	FlagCreatedBy:     "created_by",
+	FlagUpdatedBy:     "updated_by",
 }
 
 type FileDescriptor struct {
@@ -58,6 +95,36 @@ type ModelDescriptor struct {
 	Name      string
 	TableName string
 	Fields    []*FieldDescriptor
+	// Relations are the fields declared with a rel tag, they are not columns of the table
+	Relations []*RelationDescriptor `json:",omitempty"`
+	// PointerEmbeds are the embedded struct pointers whose fields are flattened into Fields,
+	// LormFieldMap allocates them before taking the addresses of their fields
+	PointerEmbeds []*EmbedDescriptor `json:",omitempty"`
+}
+
+// EmbedDescriptor stores embedded struct pointer information
+type EmbedDescriptor struct {
+	// Path is the selector of the embedded field, eg: "Base" or "Base.Meta"
+	Path string
+	// Type is the type of the embedded struct without the pointer, eg: "common.Base"
+	Type string
+}
+
+// Relation returns the relation declared on the field name, or nil if there is none
+func (m *ModelDescriptor) Relation(name string) *RelationDescriptor {
+	relation, _ := lo.Find(m.Relations, func(item *RelationDescriptor) bool {
+		return item.Name == name
+	})
+	return relation
+}
+
+// primaryKey returns the single primary key of the model, it returns false for models without or with composite primary keys
+func (m *ModelDescriptor) primaryKey() (string, bool) {
+	primaryKeys := m.FlagFields(FlagPrimaryKey)
+	if len(primaryKeys) != 1 {
+		return "", false
+	}
+	return primaryKeys[0], true
 }
 
 func (m *ModelDescriptor) FlagFields(flag FieldFlag) []string {
@@ -72,6 +139,37 @@ func (m *ModelDescriptor) AllFields() []string {
 	})
 }
 
+// RelationKind is the kind of a relation between models
+type RelationKind string
+
+const (
+	// RelHasOne means the related model holds the foreign key of the model, and there is at most one of it
+	RelHasOne RelationKind = "has_one"
+	// RelHasMany means the related models hold the foreign key of the model
+	RelHasMany RelationKind = "has_many"
+	// RelBelongsTo means the model holds the foreign key of the related model
+	RelBelongsTo RelationKind = "belongs_to"
+	// RelManyToMany means the model and the related models are linked by the rows of a join table
+	RelManyToMany RelationKind = "many_to_many"
+)
+
+// RelationDescriptor stores relation information, eg: `lorm:"rel:has_many,fk:user_id"` on a []*Address field
+type RelationDescriptor struct {
+	// Name is the name of the struct field
+	Name string
+	Kind RelationKind
+	Type string
+	// ForeignKey is the column holding the key of the other side, it is a column of the related table for has_one and has_many,
+	// a column of the model for belongs_to, and the column of the join table referencing the model for many_to_many
+	ForeignKey string
+	// References is the column referenced by ForeignKey, it defaults to the primary key of the referenced model
+	References string `json:",omitempty"`
+	// JoinTable is the join table of many_to_many, eg: `lorm:"rel:many_to_many,join:user_roles"`
+	JoinTable string `json:",omitempty"`
+	// AssociationKey is the column of the join table referencing the primary key of the related model
+	AssociationKey string `json:",omitempty"`
+}
+
 // FieldDescriptor stores field information
 type FieldDescriptor struct {
 	Name     string
@@ -79,4 +177,27 @@ type FieldDescriptor struct {
 	DBField  string
 	Type     string
 	Flag     FieldFlag
+	// Rules are the validation rules checked before the field is written
+	Rules []*Rule `json:",omitempty"`
+	// Default is the value written instead of the zero value on insert, it only takes effect with FlagDefault
+	Default string `json:",omitempty"`
+	// Layout is the time layout of created and updated times stored in string fields, eg: `lorm:"created,layout:2006-01-02T15:04:05.000Z07:00"`,
+	// time.DateTime is used if it is empty
+	Layout string `json:",omitempty"`
+}
+
+// DefaultClause returns the DEFAULT clause of the column definition, eg: "DEFAULT 18",
+// it returns an empty string if the field has no Go-side default value
+func (f *FieldDescriptor) DefaultClause() string {
+	if !f.Flag.HasFlag(FlagDefault) || f.Default == "" {
+		return ""
+	}
+	if _, err := strconv.ParseFloat(f.Default, 64); err == nil {
+		return "DEFAULT " + f.Default
+	}
+	switch strings.ToLower(f.Default) {
+	case "true", "false", "null":
+		return "DEFAULT " + strings.ToUpper(f.Default)
+	}
+	return "DEFAULT '" + strings.ReplaceAll(f.Default, "'", "''") + "'"
 }
@@ -13,3 +13,11 @@ func TestFileDescriptorHelpers(t *testing.T) {
 	s := d.JsonMarshal()
 	assert.NotEmpty(t, s)
 }
+
+func TestFieldDescriptorDefaultClause(t *testing.T) {
+	assert.Equal(t, "DEFAULT 18", (&FieldDescriptor{Flag: FlagDefault, Default: "18"}).DefaultClause())
+	assert.Equal(t, "DEFAULT TRUE", (&FieldDescriptor{Flag: FlagDefault, Default: "true"}).DefaultClause())
+	assert.Equal(t, "DEFAULT 'it''s'", (&FieldDescriptor{Flag: FlagDefault, Default: "it's"}).DefaultClause())
+	assert.Equal(t, "", (&FieldDescriptor{Flag: FlagDefault}).DefaultClause())
+	assert.Equal(t, "", (&FieldDescriptor{Default: "18"}).DefaultClause())
+}
@@ -0,0 +1,198 @@
+package lorm
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// encryptionVersion is the first byte of every ciphertext, it allows changing the format later
+const encryptionVersion byte = 1
+
+var ErrNoKeyProvider = errors.New("lorm: encrypted field requires a KeyProvider, see WithKeyProvider")
+
+// KeyProvider provides the AES keys of encrypted fields,
+// keys must be 16, 24 or 32 bytes to select AES-128, AES-192 or AES-256
+type KeyProvider interface {
+	// CurrentKey returns the key used to encrypt new values and its ID, the ID is stored in the ciphertext
+	CurrentKey() (id string, key []byte, err error)
+	// Key returns the key of the ID stored in a ciphertext, old keys must be kept to decrypt old values after rotation
+	Key(id string) ([]byte, error)
+}
+
+// KeyRing is a KeyProvider backed by a map of keys, Current is the ID of the key used to encrypt new values
+type KeyRing struct {
+	Current string
+	Keys    map[string][]byte
+}
+
+func (r *KeyRing) CurrentKey() (string, []byte, error) {
+	key, err := r.Key(r.Current)
+	return r.Current, key, err
+}
+
+func (r *KeyRing) Key(id string) ([]byte, error) {
+	key, ok := r.Keys[id]
+	if !ok {
+		return nil, fmt.Errorf("lorm: encryption key %q not found", id)
+	}
+	return key, nil
+}
+
+// EncryptedFieldWrapper encrypts the value of a field with AES-GCM when it is written and decrypts it when it is scanned.
+// The ciphertext is stored as base64 text, strings and []byte are encrypted as is, other values are encoded with codec,
+// the built-in json codec is used if codec is nil, v can also be another wrapper, eg: CodecFieldWrapper
+type EncryptedFieldWrapper struct {
+	v     any
+	keys  KeyProvider
+	codec Codec
+}
+
+func NewEncryptedFieldWrapper(v any, keys KeyProvider, codec Codec) *EncryptedFieldWrapper {
+	if codec == nil {
+		codec = defaultCodecs["json"]
+	}
+	return &EncryptedFieldWrapper{v: v, keys: keys, codec: codec}
+}
+
+func (s *EncryptedFieldWrapper) Value() (driver.Value, error) {
+	plaintext, err := s.plaintext()
+	if err != nil || plaintext == nil {
+		return nil, err
+	}
+	if s.keys == nil {
+		return nil, ErrNoKeyProvider
+	}
+	id, key, err := s.keys.CurrentKey()
+	if err != nil {
+		return nil, err
+	}
+	if len(id) > 255 {
+		return nil, fmt.Errorf("lorm: encryption key id %q is too long", id)
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	header := append([]byte{encryptionVersion, byte(len(id))}, id...)
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	ciphertext := aead.Seal(append(header, nonce...), nonce, plaintext, header)
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// plaintext returns the bytes to encrypt, nil means NULL
+func (s *EncryptedFieldWrapper) plaintext() ([]byte, error) {
+	var value reflect.Value
+	if valuer, ok := s.v.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil {
+			return nil, err
+		}
+		value = reflect.ValueOf(v)
+	} else {
+		value = reflect.ValueOf(s.v)
+	}
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil, nil
+		}
+		value = value.Elem()
+	}
+	switch {
+	case !value.IsValid():
+		return nil, nil
+	case value.Kind() == reflect.String:
+		return []byte(value.String()), nil
+	case value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8:
+		return value.Bytes(), nil
+	}
+	return s.codec.Marshal(value.Interface())
+}
+
+func (s *EncryptedFieldWrapper) Scan(src any) error {
+	var encoded []byte
+	switch v := src.(type) {
+	case nil:
+		if scanner, ok := s.v.(sql.Scanner); ok {
+			return scanner.Scan(nil)
+		}
+		value := reflect.ValueOf(s.v).Elem()
+		value.SetZero()
+		return nil
+	case []byte:
+		encoded = v
+	case string:
+		encoded = []byte(v)
+	default:
+		return fmt.Errorf("lorm: cannot decrypt %T", src)
+	}
+	plaintext, err := s.decrypt(encoded)
+	if err != nil {
+		return err
+	}
+	if scanner, ok := s.v.(sql.Scanner); ok {
+		return scanner.Scan(plaintext)
+	}
+	value := reflect.ValueOf(s.v).Elem()
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			value.Set(reflect.New(value.Type().Elem()))
+		}
+		value = value.Elem()
+	}
+	switch {
+	case value.Kind() == reflect.String:
+		value.SetString(string(plaintext))
+		return nil
+	case value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8:
+		value.SetBytes(plaintext)
+		return nil
+	}
+	return s.codec.Unmarshal(plaintext, value.Addr().Interface())
+}
+
+func (s *EncryptedFieldWrapper) decrypt(encoded []byte) ([]byte, error) {
+	if s.keys == nil {
+		return nil, ErrNoKeyProvider
+	}
+	ciphertext := make([]byte, base64.StdEncoding.DecodedLen(len(encoded)))
+	n, err := base64.StdEncoding.Decode(ciphertext, encoded)
+	if err != nil {
+		return nil, fmt.Errorf("lorm: invalid ciphertext: %w", err)
+	}
+	ciphertext = ciphertext[:n]
+	if len(ciphertext) < 2 || ciphertext[0] != encryptionVersion || len(ciphertext) < 2+int(ciphertext[1]) {
+		return nil, errors.New("lorm: invalid ciphertext header")
+	}
+	header := ciphertext[:2+int(ciphertext[1])]
+	key, err := s.keys.Key(string(header[2:]))
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	body := ciphertext[len(header):]
+	if len(body) < aead.NonceSize() {
+		return nil, errors.New("lorm: invalid ciphertext")
+	}
+	return aead.Open(nil, body[:aead.NonceSize()], body[aead.NonceSize():], header)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,64 @@
+package lorm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncryptedFieldWrapper(t *testing.T) {
+	keys := &KeyRing{Current: "k1", Keys: map[string][]byte{"k1": []byte("0123456789abcdef")}}
+	email := "alice@example.com"
+	value, err := NewEncryptedFieldWrapper(&email, keys, nil).Value()
+	assert.NoError(t, err)
+	assert.NotContains(t, value, "alice")
+
+	// rotate the key, old values can still be decrypted
+	keys.Current = "k2"
+	keys.Keys["k2"] = []byte("0123456789abcdef0123456789abcdef")
+	var decrypted string
+	assert.NoError(t, NewEncryptedFieldWrapper(&decrypted, keys, nil).Scan(value))
+	assert.Equal(t, email, decrypted)
+
+	delete(keys.Keys, "k1")
+	assert.EqualError(t, NewEncryptedFieldWrapper(&decrypted, keys, nil).Scan(value), `lorm: encryption key "k1" not found`)
+
+	var phone *string
+	value, err = NewEncryptedFieldWrapper(&phone, keys, nil).Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	_, err = NewEncryptedFieldWrapper(&email, nil, nil).Value()
+	assert.ErrorIs(t, err, ErrNoKeyProvider)
+	assert.Error(t, NewEncryptedFieldWrapper(&decrypted, keys, nil).Scan("bm90IGVuY3J5cHRlZA=="))
+}
+
+func TestEncryptedFields(t *testing.T) {
+	engine := newSQLiteEngine(t, "CREATE TABLE secret_model (id INTEGER PRIMARY KEY, email TEXT, phone TEXT, score TEXT, labels TEXT)")
+	engine.config.keyProvider = &KeyRing{Current: "k1", Keys: map[string][]byte{"k1": []byte("0123456789abcdef")}}
+	ctx := context.TODO()
+
+	m := &secretModel{ID: 1, Email: "alice@example.com", Score: 42, Labels: []string{"vip"}}
+	_, err := Insert(ctx, engine, m)
+	assert.NoError(t, err)
+
+	raw, _, err := QueryCol[string](engine).From("secret_model").Columns("email").Get(ctx)
+	assert.NoError(t, err)
+	assert.NotEqual(t, m.Email, raw)
+
+	found, err := Query[*secretModel](engine).Where("id = ?", 1).Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, m.Email, found.Email)
+	assert.Nil(t, found.Phone)
+	assert.Equal(t, 42, found.Score)
+	assert.Equal(t, []string{"vip"}, found.Labels)
+
+	phone := "123"
+	found.Phone = &phone
+	_, err = Update(engine).SetModel(found).Exec(ctx)
+	assert.NoError(t, err)
+	list, err := Query[*secretModel](engine).Find(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "123", *list[0].Phone)
+}
@@ -0,0 +1,173 @@
+package lorm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/yvvlee/lorm/builder"
+)
+
+type engineContextKey struct{}
+
+// withEngine stores the engine running a query in ctx, scanners use it to decode fields
+func withEngine(ctx context.Context, engine *Engine) context.Context {
+	return context.WithValue(ctx, engineContextKey{}, engine)
+}
+
+// engineFromContext returns the engine running the query, it returns nil if the scanner is used outside an engine
+func engineFromContext(ctx context.Context) *Engine {
+	engine, _ := ctx.Value(engineContextKey{}).(*Engine)
+	return engine
+}
+
+// wrapField wraps the pointer of a field according to its flags,
+// the returned value is bound to statements and passed to rows.Scan, engine and field can be nil
+func (e *Engine) wrapField(field *FieldDescriptor, ptr any) any {
+	if field == nil {
+		return e.convert(ptr)
+	}
+	var value any
+	if name, ok := fieldCodec(field.Flag); ok {
+		value = NewCodecFieldWrapper(ptr, e.codec(name))
+	} else {
+		value = e.convert(ptr)
+	}
+	if field.Flag.HasFlag(FlagEncrypted) {
+		value = NewEncryptedFieldWrapper(value, e.keyProvider(), e.codec("json"))
+	}
+	if field.Flag.HasFlag(FlagNullZero) {
+		value = NewNullZeroFieldWrapper(ptr, value)
+	}
+	return value
+}
+
+// primaryKeyEq returns the predicate matching the row of model by its primary keys,
+// the values are wrapped like the other bound fields so that codecs and converters apply
+func (e *Engine) primaryKeyEq(model Model) builder.Eq {
+	fieldMap := model.LormFieldMap()
+	eq := builder.Eq{}
+	for _, field := range model.LormModelDescriptor().Fields {
+		if field.Flag.HasFlag(FlagPrimaryKey) {
+			eq[e.Escaper().Escape(field.DBField)] = e.wrapField(field, fieldMap[field.DBField])
+		}
+	}
+	return eq
+}
+
+// hasPrimaryKey reports whether model has primary keys and none of them is zero
+func hasPrimaryKey(model Model) bool {
+	primaryKeys := model.LormModelDescriptor().FlagFields(FlagPrimaryKey)
+	if len(primaryKeys) == 0 {
+		return false
+	}
+	fieldMap := model.LormFieldMap()
+	for _, primaryKey := range primaryKeys {
+		if ptr, ok := fieldMap[primaryKey]; !ok || reflect.ValueOf(ptr).Elem().IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *Engine) keyProvider() KeyProvider {
+	if e == nil || e.config == nil {
+		return nil
+	}
+	return e.config.keyProvider
+}
+
+// codec returns the codec registered by name, falling back to the built-in codecs, e can be nil
+func (e *Engine) codec(name string) Codec {
+	if e != nil && e.config != nil {
+		if codec, ok := e.config.codecs[name]; ok {
+			return codec
+		}
+	}
+	if codec, ok := defaultCodecs[name]; ok {
+		return codec
+	}
+	return missingCodec(name)
+}
+
+// now returns the current time of created and updated fields according to WithClock and WithLocation
+func (e *Engine) now() time.Time {
+	if e == nil || e.config == nil {
+		return time.Now()
+	}
+	clock := time.Now
+	if e.config.clock != nil {
+		clock = e.config.clock
+	}
+	now := clock()
+	if e.config.location != nil {
+		now = now.In(e.config.location)
+	}
+	return now
+}
+
+// location returns the location set by WithLocation, time.Local is returned if it is not set
+func (e *Engine) location() *time.Location {
+	if e == nil || e.config == nil || e.config.location == nil {
+		return time.Local
+	}
+	return e.config.location
+}
+
+// fillAuditFields sets the zero fields flagged by fill and all the fields flagged by overwrite
+// to the current user returned by the auditor of the engine
+func fillAuditFields[T Model](ctx context.Context, engine *Engine, models []T, fill, overwrite FieldFlag) error {
+	if engine == nil || engine.config == nil || engine.config.auditor == nil || len(models) == 0 {
+		return nil
+	}
+	fields := models[0].LormModelDescriptor().FlagFields(fill | overwrite)
+	if len(fields) == 0 {
+		return nil
+	}
+	overwritten := models[0].LormModelDescriptor().FlagFields(overwrite)
+	user := engine.config.auditor(ctx)
+	if user == nil {
+		return nil
+	}
+	for _, model := range models {
+		fieldMap := model.LormFieldMap()
+		for _, field := range fields {
+			value := reflect.ValueOf(fieldMap[field]).Elem()
+			if !value.IsZero() && !slices.Contains(overwritten, field) {
+				continue
+			}
+			if err := assignAuditor(value, user); err != nil {
+				return fmt.Errorf("lorm: fill %s: %w", field, err)
+			}
+		}
+	}
+	return nil
+}
+
+func assignAuditor(dest reflect.Value, user any) error {
+	src := reflect.ValueOf(user)
+	switch {
+	case src.Type().AssignableTo(dest.Type()):
+		dest.Set(src)
+		return nil
+	case dest.Kind() == reflect.Pointer && convertible(src, dest.Type().Elem()):
+		value := reflect.New(dest.Type().Elem())
+		value.Elem().Set(src.Convert(dest.Type().Elem()))
+		dest.Set(value)
+		return nil
+	case convertible(src, dest.Type()):
+		dest.Set(src.Convert(dest.Type()))
+		return nil
+	}
+	return assignValue(dest, user)
+}
+
+// convertible reports whether src can be converted to typ without turning numbers into runes
+func convertible(src reflect.Value, typ reflect.Type) bool {
+	if typ.Kind() == reflect.String && src.Kind() != reflect.String {
+		return false
+	}
+	return src.Type().ConvertibleTo(typ)
+}
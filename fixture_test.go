@@ -0,0 +1,191 @@
+package lorm
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// The models of the tests, their methods are generated by lormgen into fixture_lorm_gen_test.go
+
+type assocUser struct {
+	UnimplementedTable
+	ID    int64 `lorm:"primary_key"`
+	Name  string
+	Roles []*assocRole         `lorm:"rel:many_to_many,fk:user_id,join:user_roles,assoc:role_id"`
+	Hosts []*converterKeyModel `lorm:"rel:many_to_many,fk:user_id,join:user_hosts,assoc:addr"`
+}
+
+type assocRole struct {
+	UnimplementedTable
+	ID   int64 `lorm:"primary_key"`
+	Name string
+}
+
+type auditModel struct {
+	UnimplementedTable
+	ID        int64 `lorm:"primary_key"`
+	Name      string
+	CreatedBy int64   `lorm:",created_by"`
+	UpdatedBy *string `lorm:",updated_by"`
+}
+
+type codecModel struct {
+	UnimplementedTable
+	ID    int64             `lorm:"primary_key"`
+	Tags  []string          `lorm:",csv"`
+	Attrs map[string]int    `lorm:",gob"`
+	Extra Sub               `lorm:"json"`
+	Blob  map[string]string `lorm:"msgpack"`
+}
+
+type converterModel struct {
+	UnimplementedTable
+	ID      int64 `lorm:"primary_key"`
+	Addr    netip.Addr
+	Gateway *netip.Addr
+	Level   level
+}
+
+// converterKeyModel has a primary key of a converted type
+type converterKeyModel struct {
+	UnimplementedTable
+	Addr  netip.Addr `lorm:"primary_key"`
+	Level level
+}
+
+type defaultModel struct {
+	UnimplementedTable
+	ID       int64           `lorm:"primary_key"`
+	Age      int             `lorm:"default:18"`
+	Nick     *string         `lorm:"default:anonymous"`
+	Score    decimal.Decimal `lorm:"default:1.5"`
+	Tags     []string        `lorm:"json,default:[\"a\"]"`
+	Birthday time.Time       `lorm:"default:2000-01-02 03:04:05"`
+	Status   string          `lorm:"default"`
+}
+
+// eventLog has no primary key
+type eventLog struct {
+	UnimplementedTable
+	Name string
+}
+
+type secretModel struct {
+	UnimplementedTable
+	ID     int64    `lorm:"primary_key"`
+	Email  string   `lorm:",encrypted"`
+	Phone  *string  `lorm:",encrypted"`
+	Score  int      `lorm:",encrypted"`
+	Labels []string `lorm:"json,encrypted"`
+}
+
+type hookModel struct {
+	UnimplementedTable
+	ID   int64 `lorm:"primary_key,auto_increment"`
+	Name string
+}
+
+type clockModel struct {
+	UnimplementedTable
+	ID        int64  `lorm:"primary_key"`
+	CreatedAt int64  `lorm:"created,unix_milli"`
+	UpdatedAt string `lorm:"updated,layout:2006-01-02T15:04:05Z07:00"`
+}
+
+type pkInt64 struct {
+	UnimplementedTable
+	ID int64 `lorm:"primary_key,auto_increment"`
+}
+
+type pkUint32 struct {
+	UnimplementedTable
+	ID uint32 `lorm:"primary_key,auto_increment"`
+}
+
+type pkInt16 struct {
+	UnimplementedTable
+	ID int16 `lorm:"primary_key,auto_increment"`
+}
+
+type pkUint8 struct {
+	UnimplementedTable
+	ID uint8 `lorm:"primary_key,auto_increment"`
+}
+
+type pkUint struct {
+	UnimplementedTable
+	ID uint `lorm:"primary_key,auto_increment"`
+}
+
+type pkInt struct {
+	UnimplementedTable
+	ID int `lorm:"primary_key,auto_increment"`
+}
+
+type pkInt32 struct {
+	UnimplementedTable
+	ID int32 `lorm:"primary_key,auto_increment"`
+}
+
+type pkUint16 struct {
+	UnimplementedTable
+	ID uint16 `lorm:"primary_key,auto_increment"`
+}
+
+type joinOrder struct {
+	UnimplementedTable
+	ID     int64 `lorm:"primary_key"`
+	UserID int64
+	Price  decimal.Decimal
+}
+
+type nullModel struct {
+	UnimplementedTable
+	ID       int64 `lorm:"primary_key"`
+	Nickname Null[string]
+	Score    *int
+	Extra    *Sub   `lorm:"json"`
+	Phone    string `lorm:"nullzero"`
+	Age      int    `lorm:"nullzero"`
+}
+
+type relUser struct {
+	UnimplementedTable
+	ID        int64 `lorm:"primary_key"`
+	Name      string
+	Addresses []*relAddress `lorm:"rel:has_many,fk:user_id"`
+	Profile   *relProfile   `lorm:"rel:has_one,fk:user_id"`
+}
+
+type relAddress struct {
+	UnimplementedTable
+	ID     int64 `lorm:"primary_key"`
+	UserID int32
+	City   string
+	User   relUser `lorm:"rel:belongs_to,fk:user_id"`
+}
+
+// relProfile has no primary key
+type relProfile struct {
+	UnimplementedTable
+	UserID *int64
+	Bio    string
+}
+
+type planModel struct {
+	UnimplementedTable
+	ID    int64 `lorm:"primary_key"`
+	Name  string
+	Score float64
+	Tags  []string `lorm:"json"`
+}
+
+type validateModel struct {
+	UnimplementedTable
+	ID    int64   `lorm:"primary_key"`
+	Email string  `lorm:",required,size:16,regexp:^[a-z]+@[a-z]+\\.com$"`
+	Nick  *string `lorm:",not_null,size:3"`
+	Age   int     `validate:"min:0,max:150"`
+}
@@ -0,0 +1,51 @@
+package lorm
+
+import "context"
+
+// BeforeInsertHook is implemented by models that need to run logic before they are inserted,
+// returning an error aborts the insert
+type BeforeInsertHook interface {
+	BeforeInsert(ctx context.Context) error
+}
+
+// AfterInsertHook is implemented by models that need to run logic after they are inserted
+type AfterInsertHook interface {
+	AfterInsert(ctx context.Context) error
+}
+
+// BeforeUpdateHook is implemented by models that need to run logic before they are updated,
+// returning an error aborts the update
+type BeforeUpdateHook interface {
+	BeforeUpdate(ctx context.Context) error
+}
+
+// AfterUpdateHook is implemented by models that need to run logic after they are updated
+type AfterUpdateHook interface {
+	AfterUpdate(ctx context.Context) error
+}
+
+// BeforeDeleteHook is implemented by models that need to run logic before they are deleted,
+// returning an error aborts the delete
+type BeforeDeleteHook interface {
+	BeforeDelete(ctx context.Context) error
+}
+
+// AfterFindHook is implemented by models that need to run logic after they are scanned from a query
+type AfterFindHook interface {
+	AfterFind(ctx context.Context) error
+}
+
+// callHooks calls hook on every model implementing H, it stops at the first error,
+// eg: callHooks(ctx, models, BeforeInsertHook.BeforeInsert)
+func callHooks[H any, T any](ctx context.Context, models []T, hook func(H, context.Context) error) error {
+	for _, model := range models {
+		h, ok := any(model).(H)
+		if !ok {
+			continue
+		}
+		if err := hook(h, ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
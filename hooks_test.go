@@ -0,0 +1,93 @@
+package lorm
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// hookCalls records the hooks called on every hookModel, they are not recorded in the model since lormgen maps its fields to columns
+var hookCalls = map[*hookModel][]string{}
+
+func (m *hookModel) BeforeInsert(_ context.Context) error {
+	if m.Name == "" {
+		return errors.New("name is required")
+	}
+	m.Name = strings.ToLower(m.Name)
+	hookCalls[m] = append(hookCalls[m], "BeforeInsert")
+	return nil
+}
+
+func (m *hookModel) AfterInsert(_ context.Context) error {
+	hookCalls[m] = append(hookCalls[m], "AfterInsert")
+	return nil
+}
+
+func (m *hookModel) BeforeUpdate(_ context.Context) error {
+	m.Name = strings.ToLower(m.Name)
+	hookCalls[m] = append(hookCalls[m], "BeforeUpdate")
+	return nil
+}
+
+func (m *hookModel) AfterUpdate(_ context.Context) error {
+	hookCalls[m] = append(hookCalls[m], "AfterUpdate")
+	return nil
+}
+
+func (m *hookModel) BeforeDelete(_ context.Context) error {
+	if m.Name == "protected" {
+		return errors.New("protected row")
+	}
+	return nil
+}
+
+func (m *hookModel) AfterFind(_ context.Context) error {
+	hookCalls[m] = append(hookCalls[m], "AfterFind")
+	return nil
+}
+
+func TestModelHooks(t *testing.T) {
+	engine := newSQLiteEngine(t, "CREATE TABLE hook_model (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL)")
+	repo := NewRepository[*hookModel](engine)
+	ctx := context.TODO()
+
+	_, err := repo.Insert(ctx, &hookModel{})
+	assert.EqualError(t, err, "name is required")
+
+	m := &hookModel{ID: 1, Name: "ALICE"}
+	_, err = repo.Insert(ctx, m)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"BeforeInsert", "AfterInsert"}, hookCalls[m])
+
+	models := []*hookModel{{ID: 2, Name: "BOB"}, {ID: 3, Name: "protected"}}
+	_, err = repo.InsertAll(ctx, models)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"BeforeInsert", "AfterInsert"}, hookCalls[models[1]])
+
+	delete(hookCalls, m)
+	m.Name = "ALICE2"
+	_, err = repo.Update(ctx, m)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"BeforeUpdate", "AfterUpdate"}, hookCalls[m])
+
+	found, err := repo.Get(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice2", found.Name)
+	assert.Equal(t, []string{"AfterFind"}, hookCalls[found])
+
+	list, err := Query[*hookModel](engine).OrderBy("id").Find(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, list, 3)
+	for _, item := range list {
+		assert.Equal(t, []string{"AfterFind"}, hookCalls[item])
+	}
+
+	_, err = repo.DeleteModel(ctx, list[2])
+	assert.EqualError(t, err, "protected row")
+	rowsAffected, err := repo.DeleteModel(ctx, list[1])
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, rowsAffected)
+}
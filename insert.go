@@ -20,7 +20,10 @@ func Insert[T Table](ctx context.Context, engine *Engine, table T) (rowsAffected
 	if err != nil {
 		return
 	}
-	return rowsAffected, fillModelID(table, result)
+	if err = fillModelID(table, result); err != nil {
+		return
+	}
+	return rowsAffected, callHooks(ctx, []T{table}, AfterInsertHook.AfterInsert)
 }
 
 func InsertAll[T Table](ctx context.Context, engine *Engine, models []T) (rowsAffected int64, err error) {
@@ -35,13 +38,29 @@ func InsertAll[T Table](ctx context.Context, engine *Engine, models []T) (rowsAf
 	if err != nil {
 		return 0, err
 	}
-	return result.RowsAffected()
+	rowsAffected, err = result.RowsAffected()
+	if err != nil {
+		return
+	}
+	return rowsAffected, callHooks(ctx, models, AfterInsertHook.AfterInsert)
 }
 
 func inserts[T Table](ctx context.Context, engine *Engine, models []T) (sql.Result, error) {
+	if err := fillAuditFields(ctx, engine, models, FlagCreatedBy|FlagUpdatedBy, 0); err != nil {
+		return nil, err
+	}
+	if err := callHooks(ctx, models, BeforeInsertHook.BeforeInsert); err != nil {
+		return nil, err
+	}
+	if err := validateModels(models); err != nil {
+		return nil, err
+	}
 	table := models[0].TableName()
 	insertBuilder := builder.Insert(table)
-	fields, values := ModelsToInsertData(models)
+	fields, values, err := modelsToInsertData(engine, models)
+	if err != nil {
+		return nil, err
+	}
 	escaper := engine.Escaper()
 	insertBuilder.Into(escaper.Escape(table))
 	insertBuilder.Columns(lo.Map(fields, func(field string, _ int) string {
@@ -58,34 +77,57 @@ func inserts[T Table](ctx context.Context, engine *Engine, models []T) (sql.Resu
 }
 
 func fillCurrentTime(value any, now time.Time) {
+	fillFieldTime(nil, value, now)
+}
+
+// fillFieldTime sets a zero created or updated field to now, using the precision and the layout declared by field
+func fillFieldTime(field *FieldDescriptor, value any, now time.Time) {
+	unix := now.Unix
+	layout := time.DateTime
+	if field != nil {
+		switch {
+		case field.Flag.HasFlag(FlagUnixNano):
+			unix = now.UnixNano
+		case field.Flag.HasFlag(FlagUnixMilli):
+			unix = now.UnixMilli
+			now = now.Truncate(time.Millisecond)
+		}
+		if field.Layout != "" {
+			layout = field.Layout
+		}
+	}
 	switch v := value.(type) {
 	case *time.Time:
 		if v.IsZero() {
 			*v = now
 		}
+	case **time.Time:
+		if *v == nil || (*v).IsZero() {
+			*v = &now
+		}
 	case *int64:
 		if *v == 0 {
-			*v = now.Unix()
+			*v = unix()
 		}
 	case *uint64:
 		if *v == 0 {
-			*v = uint64(now.Unix())
+			*v = uint64(unix())
 		}
 	case *int32:
 		if *v == 0 {
-			*v = int32(now.Unix())
+			*v = int32(unix())
 		}
 	case *uint32:
 		if *v == 0 {
-			*v = uint32(now.Unix())
+			*v = uint32(unix())
 		}
 	case *int:
 		if *v == 0 {
-			*v = int(now.Unix())
+			*v = int(unix())
 		}
 	case *string:
 		if *v == "" {
-			*v = now.Format(time.DateTime)
+			*v = now.Format(layout)
 		}
 	}
 }
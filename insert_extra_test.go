@@ -46,107 +46,73 @@ func TestFillCurrentTimeAllBranches(t *testing.T) {
 	}
 }
 
-func TestInsertAllEmpty(t *testing.T) {
-	var models []*Test
-	rows, err := InsertAll(context.TODO(), &Engine{config: &Config{}}, models)
-	assert.NoError(t, err)
-	assert.EqualValues(t, 0, rows)
-}
-
-type _pkInt64 struct {
-	UnimplementedTable
-	ID int64
-}
-
-func (m *_pkInt64) TableName() string            { return "test" }
-func (m *_pkInt64) New() Model                   { return new(_pkInt64) }
-func (m *_pkInt64) LormFieldMap() map[string]any { return map[string]any{"id": &m.ID} }
-func (m *_pkInt64) LormModelDescriptor() *ModelDescriptor {
-	return &ModelDescriptor{Fields: []*FieldDescriptor{{DBField: "id", Flag: FlagPrimaryKey | FlagAutoIncrement}}}
-}
-
-type _pkUint32 struct {
-	UnimplementedTable
-	ID uint32
-}
-
-func (m *_pkUint32) TableName() string            { return "test" }
-func (m *_pkUint32) New() Model                   { return new(_pkUint32) }
-func (m *_pkUint32) LormFieldMap() map[string]any { return map[string]any{"id": &m.ID} }
-func (m *_pkUint32) LormModelDescriptor() *ModelDescriptor {
-	return &ModelDescriptor{Fields: []*FieldDescriptor{{DBField: "id", Flag: FlagPrimaryKey | FlagAutoIncrement}}}
-}
-
-type _pkInt16 struct {
-	UnimplementedTable
-	ID int16
-}
-
-func (m *_pkInt16) TableName() string            { return "test" }
-func (m *_pkInt16) New() Model                   { return new(_pkInt16) }
-func (m *_pkInt16) LormFieldMap() map[string]any { return map[string]any{"id": &m.ID} }
-func (m *_pkInt16) LormModelDescriptor() *ModelDescriptor {
-	return &ModelDescriptor{Fields: []*FieldDescriptor{{DBField: "id", Flag: FlagPrimaryKey | FlagAutoIncrement}}}
-}
-
-type _pkUint8 struct {
-	UnimplementedTable
-	ID uint8
-}
-
-func (m *_pkUint8) TableName() string            { return "test" }
-func (m *_pkUint8) New() Model                   { return new(_pkUint8) }
-func (m *_pkUint8) LormFieldMap() map[string]any { return map[string]any{"id": &m.ID} }
-func (m *_pkUint8) LormModelDescriptor() *ModelDescriptor {
-	return &ModelDescriptor{Fields: []*FieldDescriptor{{DBField: "id", Flag: FlagPrimaryKey | FlagAutoIncrement}}}
-}
-
-type _pkUint struct {
-	UnimplementedTable
-	ID uint
-}
-
-func (m *_pkUint) TableName() string            { return "test" }
-func (m *_pkUint) New() Model                   { return new(_pkUint) }
-func (m *_pkUint) LormFieldMap() map[string]any { return map[string]any{"id": &m.ID} }
-func (m *_pkUint) LormModelDescriptor() *ModelDescriptor {
-	return &ModelDescriptor{Fields: []*FieldDescriptor{{DBField: "id", Flag: FlagPrimaryKey | FlagAutoIncrement}}}
-}
-
-type _pkInt struct {
-	UnimplementedTable
-	ID int
+func TestFillFieldTime(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 6789000, time.UTC)
+	{
+		var v int64
+		fillFieldTime(&FieldDescriptor{Flag: FlagCreated | FlagUnixMilli}, &v, now)
+		assert.Equal(t, now.UnixMilli(), v)
+	}
+	{
+		var v uint64
+		fillFieldTime(&FieldDescriptor{Flag: FlagCreated | FlagUnixNano}, &v, now)
+		assert.Equal(t, uint64(now.UnixNano()), v)
+	}
+	{
+		var v time.Time
+		fillFieldTime(&FieldDescriptor{Flag: FlagCreated | FlagUnixMilli}, &v, now)
+		assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 6000000, time.UTC), v)
+	}
+	{
+		var v string
+		fillFieldTime(&FieldDescriptor{Flag: FlagCreated, Layout: "2006-01-02T15:04:05.000Z07:00"}, &v, now)
+		assert.Equal(t, "2024-01-02T03:04:05.006Z", v)
+	}
+	{
+		v := int64(1)
+		fillFieldTime(&FieldDescriptor{Flag: FlagCreated | FlagUnixMilli}, &v, now)
+		assert.EqualValues(t, 1, v)
+	}
+	{
+		var v *time.Time
+		fillFieldTime(&FieldDescriptor{Flag: FlagCreated}, &v, now)
+		assert.Equal(t, &now, v)
+		set := now.Add(-time.Hour)
+		v = &set
+		fillFieldTime(&FieldDescriptor{Flag: FlagCreated}, &v, now)
+		assert.Equal(t, set, *v)
+	}
 }
 
-func (m *_pkInt) TableName() string            { return "test" }
-func (m *_pkInt) New() Model                   { return new(_pkInt) }
-func (m *_pkInt) LormFieldMap() map[string]any { return map[string]any{"id": &m.ID} }
-func (m *_pkInt) LormModelDescriptor() *ModelDescriptor {
-	return &ModelDescriptor{Fields: []*FieldDescriptor{{DBField: "id", Flag: FlagPrimaryKey | FlagAutoIncrement}}}
-}
+func TestEngineClock(t *testing.T) {
+	engine := newSQLiteEngine(t, "CREATE TABLE clock_model (id INTEGER PRIMARY KEY, created_at INTEGER, updated_at TEXT)")
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("UTC+8", 8*3600))
+	WithClock(func() time.Time { return now })(engine.config)
+	WithLocation(time.UTC)(engine.config)
+	ctx := context.TODO()
 
-type _pkInt32 struct {
-	UnimplementedTable
-	ID int32
-}
+	m := &clockModel{ID: 1}
+	_, err := Insert(ctx, engine, m)
+	assert.NoError(t, err)
+	assert.Equal(t, now.UnixMilli(), m.CreatedAt)
+	assert.Equal(t, "2024-01-01T19:04:05Z", m.UpdatedAt)
 
-func (m *_pkInt32) TableName() string            { return "test" }
-func (m *_pkInt32) New() Model                   { return new(_pkInt32) }
-func (m *_pkInt32) LormFieldMap() map[string]any { return map[string]any{"id": &m.ID} }
-func (m *_pkInt32) LormModelDescriptor() *ModelDescriptor {
-	return &ModelDescriptor{Fields: []*FieldDescriptor{{DBField: "id", Flag: FlagPrimaryKey | FlagAutoIncrement}}}
-}
+	found, err := Query[*clockModel](engine).Where("id = ?", 1).Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, m, found)
 
-type _pkUint16 struct {
-	UnimplementedTable
-	ID uint16
+	now = now.Add(time.Hour)
+	found.UpdatedAt = ""
+	_, err = Update(engine).SetModel(found).Exec(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-01T20:04:05Z", found.UpdatedAt)
 }
 
-func (m *_pkUint16) TableName() string            { return "test" }
-func (m *_pkUint16) New() Model                   { return new(_pkUint16) }
-func (m *_pkUint16) LormFieldMap() map[string]any { return map[string]any{"id": &m.ID} }
-func (m *_pkUint16) LormModelDescriptor() *ModelDescriptor {
-	return &ModelDescriptor{Fields: []*FieldDescriptor{{DBField: "id", Flag: FlagPrimaryKey | FlagAutoIncrement}}}
+func TestInsertAllEmpty(t *testing.T) {
+	var models []*Test
+	rows, err := InsertAll(context.TODO(), &Engine{config: &Config{}}, models)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, rows)
 }
 
 type fakeResult struct{ id int64 }
@@ -156,42 +122,42 @@ func (f fakeResult) RowsAffected() (int64, error) { return 1, nil }
 
 func TestFillModelIDAllTypeBranches(t *testing.T) {
 	{
-		m := &_pkInt64{}
+		m := &pkInt64{}
 		_ = fillModelID(m, fakeResult{id: 123})
 		assert.EqualValues(t, 123, m.ID)
 	}
 	{
-		m := &_pkUint32{}
+		m := &pkUint32{}
 		_ = fillModelID(m, fakeResult{id: 123})
 		assert.EqualValues(t, uint32(123), m.ID)
 	}
 	{
-		m := &_pkInt16{}
+		m := &pkInt16{}
 		_ = fillModelID(m, fakeResult{id: 123})
 		assert.EqualValues(t, int16(123), m.ID)
 	}
 	{
-		m := &_pkUint8{}
+		m := &pkUint8{}
 		_ = fillModelID(m, fakeResult{id: 123})
 		assert.EqualValues(t, uint8(123), m.ID)
 	}
 	{
-		m := &_pkUint{}
+		m := &pkUint{}
 		_ = fillModelID(m, fakeResult{id: 123})
 		assert.EqualValues(t, uint(123), m.ID)
 	}
 	{
-		m := &_pkInt{}
+		m := &pkInt{}
 		_ = fillModelID(m, fakeResult{id: 123})
 		assert.EqualValues(t, int(123), m.ID)
 	}
 	{
-		m := &_pkInt32{}
+		m := &pkInt32{}
 		_ = fillModelID(m, fakeResult{id: 123})
 		assert.EqualValues(t, int32(123), m.ID)
 	}
 	{
-		m := &_pkUint16{}
+		m := &pkUint16{}
 		_ = fillModelID(m, fakeResult{id: 123})
 		assert.EqualValues(t, uint16(123), m.ID)
 	}
@@ -0,0 +1,288 @@
+package lorm
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+
+	"github.com/samber/lo"
+	"github.com/yvvlee/lorm/builder"
+)
+
+// Tuple2 is a row of Query2
+type Tuple2[A, B Table] struct {
+	A A
+	B B
+}
+
+// Tuple3 is a row of Query3
+type Tuple3[A, B, C Table] struct {
+	A A
+	B B
+	C C
+}
+
+// Query2 selects the columns of A and B qualified by their aliases and scans every row into a Tuple2,
+// the aliases default to the table names, the query selects from the table of A and the other tables are added by joins, eg:
+//
+//	Query2[*User, *Address](engine, "u", "a").LeftJoin("address a ON a.user_id = u.id").Find(ctx)
+//
+// a model whose columns are all NULL, eg: the right side of an outer join without a match, is nil
+func Query2[A, B Table](engine *Engine, aliases ...string) *JoinQueryStmt[Tuple2[A, B]] {
+	var a A
+	var b B
+	return newJoinQuery(engine, []Table{a, b}, aliases, func(models []Model) Tuple2[A, B] {
+		return Tuple2[A, B]{A: joinedModel[A](models[0]), B: joinedModel[B](models[1])}
+	})
+}
+
+// Query3 is like Query2 with three models
+func Query3[A, B, C Table](engine *Engine, aliases ...string) *JoinQueryStmt[Tuple3[A, B, C]] {
+	var a A
+	var b B
+	var c C
+	return newJoinQuery(engine, []Table{a, b, c}, aliases, func(models []Model) Tuple3[A, B, C] {
+		return Tuple3[A, B, C]{A: joinedModel[A](models[0]), B: joinedModel[B](models[1]), C: joinedModel[C](models[2])}
+	})
+}
+
+func joinedModel[T Table](model Model) T {
+	t, _ := model.(T)
+	return t
+}
+
+// JoinQueryStmt queries rows of several models, see Query2 and Query3
+type JoinQueryStmt[T any] struct {
+	engine  *Engine
+	builder *builder.SelectBuilder
+	models  []*joinModel
+	tuple   func(models []Model) T
+}
+
+// joinModel is a model selected by a JoinQueryStmt
+type joinModel struct {
+	prototype Table
+	fields    []*FieldDescriptor
+}
+
+func newJoinQuery[T any](engine *Engine, prototypes []Table, aliases []string, tuple func([]Model) T) *JoinQueryStmt[T] {
+	escaper := engine.Escaper()
+	s := &JoinQueryStmt[T]{engine: engine, builder: new(builder.SelectBuilder), tuple: tuple}
+	var columns []string
+	for i, prototype := range prototypes {
+		table := prototype.TableName()
+		alias := table
+		if i < len(aliases) && aliases[i] != "" {
+			alias = aliases[i]
+		}
+		descriptor := prototype.LormModelDescriptor()
+		for _, field := range descriptor.Fields {
+			columns = append(columns, escaper.Escape(alias)+"."+escaper.Escape(field.DBField))
+		}
+		if i == 0 {
+			if alias == table {
+				s.builder.From(escaper.Escape(table))
+			} else {
+				s.builder.From(escaper.Escape(table) + " " + escaper.Escape(alias))
+			}
+		}
+		s.models = append(s.models, &joinModel{prototype: prototype, fields: descriptor.Fields})
+	}
+	s.builder.Select(columns...)
+	return s
+}
+
+func (s *JoinQueryStmt[T]) Get(ctx context.Context) (T, bool, error) {
+	var t T
+	rows, err := s.Iter(ctx)
+	if err != nil {
+		return t, false, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return t, false, rows.Err()
+	}
+	t, err = rows.Scan()
+	if err != nil {
+		return t, false, err
+	}
+	return t, true, nil
+}
+
+func (s *JoinQueryStmt[T]) Find(ctx context.Context) ([]T, error) {
+	var list []T
+	err := s.Each(ctx, func(t T) error {
+		list = append(list, t)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Iter runs the query and returns a cursor decoding one row at a time, the cursor must be closed
+func (s *JoinQueryStmt[T]) Iter(ctx context.Context) (*Rows[T], error) {
+	query, args, err := s.builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return newRows(ctx, s.engine, query, args, s.decode)
+}
+
+// Each calls fn for every row of the query, it stops at the first error returned by fn and returns it
+func (s *JoinQueryStmt[T]) Each(ctx context.Context, fn func(T) error) error {
+	rows, err := s.Iter(ctx)
+	if err != nil {
+		return err
+	}
+	return rows.each(fn)
+}
+
+// decode scans the columns of every model by position, so that columns of the same name do not collide
+func (s *JoinQueryStmt[T]) decode(ctx context.Context, rows *sql.Rows, columns []string) (T, error) {
+	engine := engineFromContext(ctx)
+	models := make([]Model, len(s.models))
+	dests := make([][]*columnDest, len(s.models))
+	values := make([]any, 0, len(columns))
+	for i, m := range s.models {
+		models[i] = m.prototype.New()
+		fieldMap := models[i].LormFieldMap()
+		for _, field := range m.fields {
+			dest := newColumnDest(engine.wrapField(field, fieldMap[field.DBField]))
+			dests[i] = append(dests[i], dest)
+			values = append(values, dest.value)
+		}
+	}
+	// extra columns, eg: added by Column, are discarded
+	for len(values) < len(columns) {
+		values = append(values, new(sql.RawBytes))
+	}
+	var t T
+	if err := rows.Scan(values...); err != nil {
+		return t, err
+	}
+	for i := range models {
+		if lo.EveryBy(dests[i], (*columnDest).isNull) {
+			models[i] = nil
+			continue
+		}
+		for _, dest := range dests[i] {
+			dest.assign()
+		}
+		if err := callHooks(ctx, models[i:i+1], AfterFindHook.AfterFind); err != nil {
+			return t, err
+		}
+	}
+	return s.tuple(models), nil
+}
+
+// columnDest scans a column into dest and records whether it is NULL, plain pointers are scanned through
+// a pointer to pointer and scanners skip NULL so that NULL leaves them unchanged instead of failing, see assign
+type columnDest struct {
+	dest   any
+	value  any
+	holder reflect.Value
+	null   bool
+}
+
+func newColumnDest(dest any) *columnDest {
+	d := &columnDest{dest: dest}
+	if _, ok := dest.(sql.Scanner); ok {
+		d.value = d
+		return d
+	}
+	d.holder = reflect.New(reflect.TypeOf(dest))
+	d.value = d.holder.Interface()
+	return d
+}
+
+// Scan records a NULL src without passing it to dest, since scanners like decimal.Decimal reject NULL,
+// the field is left at its zero value
+func (d *columnDest) Scan(src any) error {
+	d.null = src == nil
+	if d.null {
+		return nil
+	}
+	return d.dest.(sql.Scanner).Scan(src)
+}
+
+func (d *columnDest) isNull() bool {
+	if d.holder.IsValid() {
+		return d.holder.Elem().IsNil()
+	}
+	return d.null
+}
+
+// assign stores the scanned value into dest
+func (d *columnDest) assign() {
+	if d.holder.IsValid() && !d.holder.Elem().IsNil() {
+		reflect.ValueOf(d.dest).Elem().Set(d.holder.Elem().Elem())
+	}
+}
+
+// Where adds an expression to the WHERE clause of the query, see QueryModelStmt.Where
+func (s *JoinQueryStmt[T]) Where(pred any, args ...any) *JoinQueryStmt[T] {
+	s.builder.Where(pred, args...)
+	return s
+}
+
+// Join adds a JOIN clause to the query.
+func (s *JoinQueryStmt[T]) Join(join string, rest ...any) *JoinQueryStmt[T] {
+	s.builder.Join(join, rest...)
+	return s
+}
+
+// LeftJoin adds a LEFT JOIN clause to the query.
+func (s *JoinQueryStmt[T]) LeftJoin(join string, rest ...any) *JoinQueryStmt[T] {
+	s.builder.LeftJoin(join, rest...)
+	return s
+}
+
+// RightJoin adds a RIGHT JOIN clause to the query.
+func (s *JoinQueryStmt[T]) RightJoin(join string, rest ...any) *JoinQueryStmt[T] {
+	s.builder.RightJoin(join, rest...)
+	return s
+}
+
+// InnerJoin adds a INNER JOIN clause to the query.
+func (s *JoinQueryStmt[T]) InnerJoin(join string, rest ...any) *JoinQueryStmt[T] {
+	s.builder.InnerJoin(join, rest...)
+	return s
+}
+
+// CrossJoin adds a CROSS JOIN clause to the query.
+func (s *JoinQueryStmt[T]) CrossJoin(join string, rest ...any) *JoinQueryStmt[T] {
+	s.builder.CrossJoin(join, rest...)
+	return s
+}
+
+// GroupBy adds GROUP BY expressions to the query.
+func (s *JoinQueryStmt[T]) GroupBy(groupBys ...string) *JoinQueryStmt[T] {
+	s.builder.GroupBy(groupBys...)
+	return s
+}
+
+// Having adds an expression to the HAVING clause of the query.
+func (s *JoinQueryStmt[T]) Having(pred any, rest ...any) *JoinQueryStmt[T] {
+	s.builder.Having(pred, rest...)
+	return s
+}
+
+// OrderBy adds ORDER BY expressions to the query.
+func (s *JoinQueryStmt[T]) OrderBy(orderBys ...string) *JoinQueryStmt[T] {
+	s.builder.OrderBy(orderBys...)
+	return s
+}
+
+// Limit sets a LIMIT clause on the query.
+func (s *JoinQueryStmt[T]) Limit(limit uint64) *JoinQueryStmt[T] {
+	s.builder.Limit(limit)
+	return s
+}
+
+// Offset sets a OFFSET clause on the query.
+func (s *JoinQueryStmt[T]) Offset(offset uint64) *JoinQueryStmt[T] {
+	s.builder.Offset(offset)
+	return s
+}
@@ -0,0 +1,80 @@
+package lorm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuery2(t *testing.T) {
+	engine := newRelationEngine(t)
+	ctx := context.TODO()
+
+	rows, err := Query2[*relUser, *relAddress](engine, "u", "a").
+		LeftJoin("rel_address a ON a.user_id = u.id").
+		OrderBy("u.id", "a.id").
+		Find(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, rows, 4) {
+		assert.Equal(t, &relUser{ID: 1, Name: "a"}, rows[0].A)
+		assert.Equal(t, &relAddress{ID: 1, UserID: 1, City: "x"}, rows[0].B)
+		assert.Equal(t, &relAddress{ID: 3, UserID: 1, City: "z"}, rows[1].B)
+		assert.Equal(t, &relAddress{ID: 2, UserID: 2, City: "y"}, rows[2].B)
+		assert.Equal(t, &relUser{ID: 3, Name: "c"}, rows[3].A)
+		assert.Nil(t, rows[3].B)
+	}
+
+	row, ok, err := Query2[*relAddress, *relUser](engine).
+		Join("rel_user ON rel_user.id = rel_address.user_id").
+		Where("rel_address.id = ?", 2).
+		Get(ctx)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "y", row.A.City)
+	assert.Equal(t, "b", row.B.Name)
+}
+
+func TestQuery3(t *testing.T) {
+	engine := newRelationEngine(t)
+	ctx := context.TODO()
+
+	rows, err := Query3[*relUser, *relAddress, *relProfile](engine, "u", "a", "p").
+		LeftJoin("rel_address a ON a.user_id = u.id").
+		LeftJoin("rel_profile p ON p.user_id = u.id").
+		Where("u.id > ?", 1).
+		OrderBy("u.id").
+		Find(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, rows, 2) {
+		assert.Equal(t, "y", rows[0].B.City)
+		assert.Equal(t, "bio", rows[0].C.Bio)
+		assert.EqualValues(t, 2, *rows[0].C.UserID)
+		assert.EqualValues(t, 3, rows[1].A.ID)
+		assert.Nil(t, rows[1].B)
+		assert.Nil(t, rows[1].C)
+	}
+}
+
+func TestQuery2LeftJoinScanner(t *testing.T) {
+	engine := newRelationEngine(t)
+	ctx := context.TODO()
+	_, err := engine.Exec(ctx, "CREATE TABLE join_order (id INTEGER PRIMARY KEY, user_id INTEGER, price DECIMAL(10,2))")
+	assert.NoError(t, err)
+	_, err = engine.Exec(ctx, "INSERT INTO join_order VALUES (1, 1, '9.5')")
+	assert.NoError(t, err)
+
+	// decimal.Decimal rejects NULL, the user without orders has no order
+	rows, err := Query2[*relUser, *joinOrder](engine, "u", "o").
+		LeftJoin("join_order o ON o.user_id = u.id").
+		OrderBy("u.id").
+		Find(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, rows, 3) {
+		if assert.NotNil(t, rows[0].B) {
+			assert.Equal(t, "9.5", rows[0].B.Price.String())
+		}
+		assert.Nil(t, rows[1].B)
+		assert.Nil(t, rows[2].B)
+	}
+}
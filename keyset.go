@@ -0,0 +1,267 @@
+package lorm
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidCursor is returned by PageAfter when the cursor is malformed or does not match the ORDER BY of the query
+var ErrInvalidCursor = errors.New("lorm: invalid cursor")
+
+// PageAfter returns a page of size models by keyset pagination, which does not degrade on deep pages like Page,
+// the query itself is left unchanged,
+// the models are ordered by the ORDER BY of the query, which must list fields of the model, the primary keys
+// are appended to it unless they are listed so that the order is unique, the sort fields must not be NULL.
+//
+// an empty cursor returns the first page, next and prev are the opaque cursors of the following and preceding pages,
+// they are empty if there is no such page, eg:
+//
+//	users, next, prev, err := Query[*User](engine).OrderBy("created_at DESC").PageAfter(ctx, cursor, 20)
+func (s *QueryModelStmt[T]) PageAfter(ctx context.Context, cursor string, size uint64) (list []T, next, prev string, err error) {
+	if size == 0 {
+		return nil, "", "", errors.New("size can not be zero")
+	}
+	s = s.clone()
+	keys, err := s.sortKeys()
+	if err != nil {
+		return nil, "", "", err
+	}
+	var c keysetCursor
+	if cursor != "" {
+		if c, err = s.engine.decodeCursor(keys, cursor); err != nil {
+			return nil, "", "", err
+		}
+		if err = s.whereAfter(keys, c); err != nil {
+			return nil, "", "", err
+		}
+	}
+	s.builder.RemoveOrderBy()
+	for _, key := range keys {
+		s.builder.OrderBy(key.orderBy(c.Before))
+	}
+	s.builder.Limit(size + 1)
+	if list, err = s.Find(ctx); err != nil {
+		return nil, "", "", err
+	}
+	more := uint64(len(list)) > size
+	if more {
+		list = list[:size]
+	}
+	if c.Before {
+		slices.Reverse(list)
+	}
+	if len(list) == 0 {
+		return list, "", "", nil
+	}
+	hasNext, hasPrev := more, cursor != ""
+	if c.Before {
+		hasNext, hasPrev = true, more
+	}
+	if hasNext {
+		if next, err = encodeCursor(s.engine, keys, list[len(list)-1], false); err != nil {
+			return nil, "", "", err
+		}
+	}
+	if hasPrev {
+		if prev, err = encodeCursor(s.engine, keys, list[0], true); err != nil {
+			return nil, "", "", err
+		}
+	}
+	return list, next, prev, nil
+}
+
+// sortKey is a column of the ORDER BY of a keyset page
+type sortKey struct {
+	// expr is the column as written in the ORDER BY, eg: u.created_at
+	expr  string
+	field *FieldDescriptor
+	desc  bool
+}
+
+// orderBy returns the ORDER BY expression of the key, reverse reverses the direction for the preceding page
+func (k sortKey) orderBy(reverse bool) string {
+	if k.desc != reverse {
+		return k.expr + " DESC"
+	}
+	return k.expr + " ASC"
+}
+
+// sortKeys parses the ORDER BY of the query and appends the primary keys missing from it
+func (s *QueryModelStmt[T]) sortKeys() ([]sortKey, error) {
+	var t T
+	descriptor := t.LormModelDescriptor()
+	fields := descriptorFieldMap(descriptor)
+	orderBys, err := s.builder.OrderBys()
+	if err != nil {
+		return nil, err
+	}
+	// the sort keys can be qualified by the table of T or the alias of FROM
+	tables := []string{descriptor.TableName}
+	if from := s.builder.FromName(); from != "" && from != descriptor.TableName {
+		tables = append(tables, from)
+	}
+	var keys []sortKey
+	for _, orderBy := range orderBys {
+		for _, term := range strings.Split(orderBy, ",") {
+			key, err := parseSortKey(term, fields, tables)
+			if err != nil {
+				return nil, fmt.Errorf("lorm: keyset pagination of %s: %w", descriptor.Name, err)
+			}
+			keys = append(keys, key)
+		}
+	}
+	for _, primaryKey := range descriptor.FlagFields(FlagPrimaryKey) {
+		if !slices.ContainsFunc(keys, func(key sortKey) bool { return key.field.DBField == primaryKey }) {
+			keys = append(keys, sortKey{expr: s.engine.Escaper().Escape(primaryKey), field: fields[primaryKey]})
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("lorm: keyset pagination of %s requires an ORDER BY or a primary key", descriptor.Name)
+	}
+	return keys, nil
+}
+
+// parseSortKey parses an ORDER BY term like "u.created_at DESC", a qualified column must be qualified by one of tables
+func parseSortKey(term string, fields map[string]*FieldDescriptor, tables []string) (sortKey, error) {
+	words := strings.Fields(term)
+	if len(words) == 0 || len(words) > 2 {
+		return sortKey{}, fmt.Errorf("unsupported ORDER BY %q", strings.TrimSpace(term))
+	}
+	key := sortKey{expr: words[0]}
+	if len(words) == 2 {
+		switch strings.ToUpper(words[1]) {
+		case "ASC":
+		case "DESC":
+			key.desc = true
+		default:
+			return sortKey{}, fmt.Errorf("unsupported ORDER BY %q", strings.TrimSpace(term))
+		}
+	}
+	i := strings.LastIndex(key.expr, ".")
+	if i >= 0 && !slices.Contains(tables, strings.Trim(key.expr[:i], "`\"[]")) {
+		return sortKey{}, fmt.Errorf("ORDER BY %s is not a column of %s", key.expr, strings.Join(tables, " or "))
+	}
+	key.field = fields[strings.Trim(key.expr[i+1:], "`\"[]")]
+	if key.field == nil {
+		return sortKey{}, fmt.Errorf("ORDER BY %s is not a field", key.expr)
+	}
+	return key, nil
+}
+
+// keysetCursor is the decoded cursor of a keyset page, it is encoded with the json codec of the engine
+type keysetCursor struct {
+	// Before is true for the cursor of the preceding page
+	Before bool `json:"b,omitempty"`
+	// Keys is the fingerprint of the sort keys, a cursor of another ORDER BY is rejected
+	Keys uint32 `json:"k"`
+	// Values are the encoded values of the sort keys of the first or the last model of a page
+	Values []string `json:"v"`
+}
+
+// fingerprint hashes the expressions and the directions of keys
+func fingerprint(keys []sortKey) uint32 {
+	h := fnv.New32a()
+	for _, key := range keys {
+		h.Write([]byte(key.orderBy(false) + ","))
+	}
+	return h.Sum32()
+}
+
+func encodeCursor[T Model](engine *Engine, keys []sortKey, model T, before bool) (string, error) {
+	codec := engine.codec("json")
+	fieldMap := model.LormFieldMap()
+	c := keysetCursor{Before: before, Keys: fingerprint(keys)}
+	for _, key := range keys {
+		ptr := fieldMap[key.field.DBField]
+		if isNullField(key.field, ptr) {
+			return "", fmt.Errorf("lorm: keyset pagination can not compare NULL %s", key.field.DBField)
+		}
+		data, err := codec.Marshal(reflect.ValueOf(ptr).Elem().Interface())
+		if err != nil {
+			return "", err
+		}
+		c.Values = append(c.Values, string(data))
+	}
+	data, err := codec.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor decodes cursor and checks that it was returned for keys
+func (e *Engine) decodeCursor(keys []sortKey, cursor string) (keysetCursor, error) {
+	var c keysetCursor
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return c, ErrInvalidCursor
+	}
+	if err = e.codec("json").Unmarshal(data, &c); err != nil {
+		return c, ErrInvalidCursor
+	}
+	if c.Keys != fingerprint(keys) || len(c.Values) != len(keys) {
+		return c, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// whereAfter adds the predicate of the models following the cursor, or preceding it if it is a Before cursor, see whereKeys
+func (s *QueryModelStmt[T]) whereAfter(keys []sortKey, c keysetCursor) error {
+	var t T
+	fieldMap := t.New().LormFieldMap()
+	codec := s.engine.codec("json")
+	args := make([]any, len(keys))
+	for i, key := range keys {
+		ptr := reflect.New(reflect.TypeOf(fieldMap[key.field.DBField]).Elem())
+		if err := codec.Unmarshal([]byte(c.Values[i]), ptr.Interface()); err != nil {
+			return ErrInvalidCursor
+		}
+		args[i] = s.engine.wrapField(key.field, ptr.Interface())
+	}
+	s.whereKeys(keys, args, c.Before)
+	return nil
+}
+
+// whereKeys adds the predicate of the models following the values args of keys, or preceding them if before is true
+func (s *QueryModelStmt[T]) whereKeys(keys []sortKey, args []any, before bool) {
+	// a key follows the cursor if it is greater in ascending order, the direction is reversed for the preceding page
+	greater := func(key sortKey) bool {
+		return key.desc == before
+	}
+	sameDirection := !slices.ContainsFunc(keys, func(key sortKey) bool { return greater(key) != greater(keys[0]) })
+	if len(keys) > 1 && sameDirection && RowComparison(s.engine.config.driverName) {
+		exprs := make([]string, len(keys))
+		for i, key := range keys {
+			exprs[i] = key.expr
+		}
+		s.builder.Where(fmt.Sprintf("(%s) %s (%s)", strings.Join(exprs, ", "), comparison(greater(keys[0])),
+			strings.TrimSuffix(strings.Repeat("?, ", len(keys)), ", ")), args...)
+		return
+	}
+	// (a > ?) OR (a = ? AND b > ?) OR ...
+	var ors []string
+	var orArgs []any
+	for i, key := range keys {
+		var ands []string
+		for _, prefix := range keys[:i] {
+			ands = append(ands, prefix.expr+" = ?")
+		}
+		ands = append(ands, key.expr+" "+comparison(greater(key))+" ?")
+		orArgs = append(orArgs, args[:i+1]...)
+		ors = append(ors, "("+strings.Join(ands, " AND ")+")")
+	}
+	s.builder.Where("("+strings.Join(ors, " OR ")+")", orArgs...)
+}
+
+func comparison(greater bool) string {
+	if greater {
+		return ">"
+	}
+	return "<"
+}
@@ -0,0 +1,124 @@
+package lorm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func planIDs(models []*planModel) []int64 {
+	ids := make([]int64, 0, len(models))
+	for _, model := range models {
+		ids = append(ids, model.ID)
+	}
+	return ids
+}
+
+func TestPageAfter(t *testing.T) {
+	engine := newPlanEngine(t, 7)
+	ctx := context.TODO()
+	// names: n1 n2 n3 odd odd odd odd ordered by name DESC and then id ASC
+	_, err := engine.Exec(ctx, "UPDATE plan_model SET name = 'odd' WHERE id > 3")
+	assert.NoError(t, err)
+	query := func() *QueryModelStmt[*planModel] {
+		return Query[*planModel](engine).Where("id <> ?", 100).OrderBy("name DESC")
+	}
+
+	list, next, prev, err := query().PageAfter(ctx, "", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 5, 6}, planIDs(list))
+	assert.NotEmpty(t, next)
+	assert.Empty(t, prev)
+
+	list, next, prev, err = query().PageAfter(ctx, next, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{7, 3, 2}, planIDs(list))
+	assert.NotEmpty(t, prev)
+
+	list, last, prev2, err := query().PageAfter(ctx, next, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1}, planIDs(list))
+	assert.Empty(t, last)
+	assert.NotEmpty(t, prev2)
+
+	// back to the second and the first page
+	list, _, _, err = query().PageAfter(ctx, prev2, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{7, 3, 2}, planIDs(list))
+	list, next, prev, err = query().PageAfter(ctx, prev, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 5, 6}, planIDs(list))
+	assert.NotEmpty(t, next)
+	assert.Empty(t, prev)
+
+	// the primary key orders the pages without an ORDER BY
+	list, next, _, err = Query[*planModel](engine).PageAfter(ctx, "", 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3, 4}, planIDs(list))
+	list, _, _, err = Query[*planModel](engine).PageAfter(ctx, next, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{5, 6, 7}, planIDs(list))
+
+	_, _, _, err = query().PageAfter(ctx, "not a cursor", 3)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+	// a cursor of another ORDER BY is rejected even if it has as many keys
+	_, _, _, err = Query[*planModel](engine).OrderBy("score DESC").PageAfter(ctx, prev2, 3)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+	_, _, _, err = Query[*planModel](engine).OrderBy("LENGTH(name)").PageAfter(ctx, "", 3)
+	assert.EqualError(t, err, "lorm: keyset pagination of planModel: ORDER BY LENGTH(name) is not a field")
+
+	// the sort keys can be qualified by the table or the alias of FROM, but not by another table
+	list, _, _, err = Query[*planModel](engine).OrderBy("plan_model.name DESC").PageAfter(ctx, "", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 5, 6}, planIDs(list))
+	list, _, _, err = Query[*planModel](engine).From("plan_model AS p").OrderBy("p.name DESC").PageAfter(ctx, "", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 5, 6}, planIDs(list))
+	_, _, _, err = Query[*planModel](engine).From("plan_model AS p").Join("plan_model AS other ON other.id = p.id").
+		OrderBy("other.id").PageAfter(ctx, "", 3)
+	assert.EqualError(t, err, "lorm: keyset pagination of planModel: ORDER BY other.id is not a column of plan_model or p")
+}
+
+func TestPageAfterNull(t *testing.T) {
+	engine := newRowsEngine(t)
+	ctx := context.TODO()
+	// nickname is an invalid Null[string] in every row
+	_, _, _, err := Query[*nullModel](engine).OrderBy("nickname").PageAfter(ctx, "", 3)
+	assert.EqualError(t, err, "lorm: keyset pagination can not compare NULL nickname")
+	_, _, _, err = Query[*nullModel](engine).OrderBy("score").PageAfter(ctx, "", 3)
+	assert.EqualError(t, err, "lorm: keyset pagination can not compare NULL score")
+}
+
+func TestKeysetPredicate(t *testing.T) {
+	engine := newPlanEngine(t, 1)
+	keys := []sortKey{
+		{expr: "name", field: planModelDescriptor.Fields[1]},
+		{expr: "id", field: planModelDescriptor.Fields[0]},
+	}
+	cursor, err := encodeCursor(engine, keys, &planModel{ID: 3, Name: "a"}, false)
+	assert.NoError(t, err)
+	c, err := engine.decodeCursor(keys, cursor)
+	assert.NoError(t, err)
+	_, err = engine.decodeCursor(keys[:1], cursor)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	s := Query[*planModel](engine)
+	assert.NoError(t, s.whereAfter(keys, c))
+	query, args, err := s.builder.ToSql()
+	assert.NoError(t, err)
+	assert.Contains(t, query, "WHERE (name, id) > (?, ?)")
+	assert.Len(t, args, 2)
+
+	// mixed directions and dialects without row comparison use the expanded form
+	keys[0].desc = true
+	s = Query[*planModel](engine)
+	assert.NoError(t, s.whereAfter(keys, c))
+	query, args, err = s.builder.ToSql()
+	assert.NoError(t, err)
+	assert.Contains(t, query, "WHERE ((name < ?) OR (name = ? AND id > ?))")
+	assert.Len(t, args, 3)
+
+	assert.True(t, RowComparison("mysql"))
+	assert.False(t, RowComparison("sqlserver"))
+}
@@ -74,7 +74,7 @@ func (e *Engine) session(ctx context.Context) *session {
 
 func (e *Engine) TX(ctx context.Context, fn func(context.Context) error) error {
 	// If a transaction is currently open, get the transaction session
-	if _, ok := ctx.Value(e).(session); ok {
+	if e.inTX(ctx) {
 		return fn(ctx)
 	}
 	s, err := e.beginTxSession(ctx)
@@ -92,6 +92,15 @@ func (e *Engine) TX(ctx context.Context, fn func(context.Context) error) error {
 	return s.commit()
 }
 
+// inTX reports whether ctx carries a transaction of the engine, see TX
+func (e *Engine) inTX(ctx context.Context) bool {
+	switch ctx.Value(e).(type) {
+	case session, *session:
+		return true
+	}
+	return false
+}
+
 func (e *Engine) beginTxSession(ctx context.Context) (*session, error) {
 	tx, err := e.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -147,6 +156,29 @@ func (e *Engine) Query(ctx context.Context, scanner Scanner, query string, args
 	return
 }
 
+// rows runs query and leaves the rows open for iteration, the returned function must be called to release them
+func (e *Engine) rows(ctx context.Context, query string, args ...any) (rows *sql.Rows, closer func() error, err error) {
+	startTime := time.Now()
+	defer func() {
+		if err != nil {
+			e.logger.ErrorContext(ctx, "lorm execute error",
+				"err", err,
+				"SQL", query,
+				"args", args,
+				"executeTime", time.Since(startTime).Seconds(),
+			)
+			return
+		}
+		e.logger.InfoContext(ctx, "lorm execute success",
+			"SQL", query,
+			"args", args,
+			"executeTime", time.Since(startTime).Seconds(),
+		)
+	}()
+	rows, closer, err = e.session(ctx).Rows(ctx, query, args...)
+	return
+}
+
 func (e *Engine) Exist(ctx context.Context, query string, args ...any) (exist bool, err error) {
 	startTime := time.Now()
 	defer func() {
@@ -220,6 +252,19 @@ func Escaper(driverName string) names.Escaper {
 	}
 }
 
+// RowComparison reports whether the dialect of driverName supports row value comparisons, eg: (a, b) > (?, ?)
+func RowComparison(driverName string) bool {
+	switch driverName {
+	case //Oracle
+		"oci8", "ora", "goracle", "godror",
+		//SQL Server
+		"sqlserver", "mssql":
+		return false
+	default:
+		return true
+	}
+}
+
 type Execer interface {
 	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
 }
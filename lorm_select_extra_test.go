@@ -2,9 +2,11 @@ package lorm
 
 import (
 	"context"
-	"github.com/stretchr/testify/assert"
+	"database/sql"
 	"os"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestQueryModelExistBranches(t *testing.T) {
@@ -53,3 +55,66 @@ func TestQueryColGetFalseAndError(t *testing.T) {
 	_, _, err = QueryCol[uint64](e).Prefix("INVALID").From("test").Columns("id").Limit(1).Get(ctx)
 	assert.Error(t, err)
 }
+
+func TestQueryNotFound(t *testing.T) {
+	engine := newRowsEngine(t)
+	ctx := context.TODO()
+	assert.ErrorIs(t, ErrNotFound, sql.ErrNoRows)
+
+	m, err := Query[*nullModel](engine).Where("id > ?", 10).Get(ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, m)
+	_, err = Query[*nullModel](engine).Where("id > ?", 10).MustGet(ctx)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = Query[*nullModel](engine).Where("id > ?", 10).Take(ctx)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	m, err = Query[*nullModel](engine).Where("id > ?", 3).First(ctx)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4, m.ID)
+	m, err = Query[*nullModel](engine).Where("id < ?", 3).Last(ctx)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, m.ID)
+	m, err = Query[*nullModel](engine).Where("id = ?", 5).Take(ctx)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 5, m.ID)
+	_, err = Query[*nullModel](engine).Where("id > ?", 10).Last(ctx)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// the statement is not changed and can be reused
+	stmt := Query[*nullModel](engine).Where("id BETWEEN ? AND ?", 3, 6)
+	m, err = stmt.First(ctx)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, m.ID)
+	m, err = stmt.Last(ctx)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 6, m.ID)
+	_, err = stmt.Take(ctx)
+	assert.NoError(t, err)
+	list, err := stmt.Find(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, list, 4)
+
+	_, ok, err := QueryCol[int64](engine).From("null_model").Columns("id").Where("id > ?", 10).Get(ctx)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	_, err = QueryCol[int64](engine).From("null_model").Columns("id").Where("id > ?", 10).MustGet(ctx)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = engine.Exec(ctx, "UPDATE null_model SET age = id % 2")
+	assert.NoError(t, err)
+	repo := NewRepository[*nullModel](engine)
+	m, err = repo.MustGet(ctx, 7)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 7, m.ID)
+	_, err = repo.MustGet(ctx, 11)
+	assert.ErrorIs(t, err, ErrNotFound)
+	m, err = repo.FirstByField(ctx, "age", 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, m.ID)
+	m, err = repo.LastByField(ctx, "age", 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 9, m.ID)
+	_, err = repo.FirstByField(ctx, "age", 2)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,351 @@
+package lorm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cast"
+	"github.com/yvvlee/lorm/builder"
+)
+
+// QueryMaps queries rows whose columns are only known at runtime, every row is returned as a map by column name,
+// see MapScanner for the conversion of the values
+func QueryMaps(engine *Engine) *QueryMapStmt {
+	return &QueryMapStmt{
+		engine:  engine,
+		builder: new(builder.SelectBuilder),
+	}
+}
+
+type QueryMapStmt struct {
+	engine  *Engine
+	builder *builder.SelectBuilder
+}
+
+func (s *QueryMapStmt) Get(ctx context.Context) (map[string]any, bool, error) {
+	query, args, err := s.builder.ToSql()
+	if err != nil {
+		return nil, false, err
+	}
+	var maps []map[string]any
+	// the rows are closed after the first one
+	if err = s.engine.Query(ctx, &MapScanner{v: &maps, limit: 1}, query, args...); err != nil {
+		return nil, false, err
+	}
+	if len(maps) == 0 {
+		return nil, false, nil
+	}
+	return maps[0], true, nil
+}
+
+func (s *QueryMapStmt) Find(ctx context.Context) ([]map[string]any, error) {
+	query, args, err := s.builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var maps []map[string]any
+	if err = s.engine.Query(ctx, NewMapScanner(&maps), query, args...); err != nil {
+		return nil, err
+	}
+	return maps, nil
+}
+
+// MapScanner scans rows into maps by column name, the values are converted by the database types of the columns:
+// integers to int64, floats to float64, booleans to bool, dates and times to time.Time, JSON to the values decoded by the json codec,
+// binary columns to []byte, decimals and other text to string, and NULL to nil
+type MapScanner struct {
+	v *[]map[string]any
+	// limit is the maximum number of rows to scan, 0 means no limit
+	limit int
+}
+
+func NewMapScanner(v *[]map[string]any) *MapScanner {
+	return &MapScanner{v: v}
+}
+
+func (m *MapScanner) Scan(rows *sql.Rows) error {
+	return m.ScanContext(context.Background(), rows)
+}
+
+// ScanContext decodes JSON columns with the json codec of the engine running the query
+func (m *MapScanner) ScanContext(ctx context.Context, rows *sql.Rows) error {
+	engine := engineFromContext(ctx)
+	columns, err := rows.ColumnTypes()
+	if err != nil {
+		return err
+	}
+	var maps []map[string]any
+	values := make([]any, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	for rows.Next() {
+		if err = rows.Scan(dest...); err != nil {
+			return err
+		}
+		item := make(map[string]any, len(columns))
+		for i, column := range columns {
+			if item[column.Name()], err = engine.convertColumnValue(column, values[i]); err != nil {
+				return err
+			}
+		}
+		maps = append(maps, item)
+		if m.limit > 0 && len(maps) >= m.limit {
+			break
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	*m.v = maps
+	return nil
+}
+
+// convertColumnValue converts a value scanned into any according to the database type of its column,
+// drivers return text protocol values as []byte, eg: MySQL without parseTime
+func (e *Engine) convertColumnValue(column *sql.ColumnType, v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	typeName := strings.ToUpper(column.DatabaseTypeName())
+	switch {
+	case strings.Contains(typeName, "JSON"):
+		var data []byte
+		switch v := v.(type) {
+		case []byte:
+			data = v
+		case string:
+			data = []byte(v)
+		default:
+			return v, nil
+		}
+		var value any
+		if err := e.codec("json").Unmarshal(data, &value); err != nil {
+			return nil, fmt.Errorf("lorm: decode json column %s: %w", column.Name(), err)
+		}
+		return value, nil
+	case strings.Contains(typeName, "BLOB"), strings.Contains(typeName, "BINARY"), typeName == "BYTEA":
+		if b, ok := v.([]byte); ok {
+			return append([]byte(nil), b...), nil
+		}
+		return v, nil
+	}
+	b, ok := v.([]byte)
+	if !ok {
+		return v, nil
+	}
+	s := string(b)
+	switch {
+	case typeName == "BOOL" || typeName == "BOOLEAN":
+		return strconv.ParseBool(s)
+	case isIntegerType(typeName):
+		if strings.HasPrefix(typeName, "UNSIGNED") {
+			return strconv.ParseUint(s, 10, 64)
+		}
+		return strconv.ParseInt(s, 10, 64)
+	case typeName == "FLOAT" || typeName == "DOUBLE" || typeName == "REAL":
+		return strconv.ParseFloat(s, 64)
+	case typeName == "DATE" || typeName == "DATETIME" || strings.HasPrefix(typeName, "TIMESTAMP"):
+		return cast.ToTimeE(s)
+	}
+	return s, nil
+}
+
+// integerTypes are the database type names of integer columns, without the UNSIGNED prefix and the display width
+var integerTypes = map[string]bool{
+	"INT": true, "INTEGER": true, "TINYINT": true, "SMALLINT": true, "MEDIUMINT": true, "BIGINT": true,
+	"INT2": true, "INT4": true, "INT8": true, "SERIAL": true, "SMALLSERIAL": true, "BIGSERIAL": true,
+}
+
+// isIntegerType reports whether typeName is an integer type, eg: "UNSIGNED BIGINT" or "INT(11)", but not "POINT" or "INTERVAL"
+func isIntegerType(typeName string) bool {
+	typeName = strings.TrimPrefix(typeName, "UNSIGNED ")
+	typeName, _, _ = strings.Cut(typeName, "(")
+	return integerTypes[strings.TrimSpace(typeName)]
+}
+
+// Prefix adds an expression to the beginning of the query
+func (s *QueryMapStmt) Prefix(sql string, args ...any) *QueryMapStmt {
+	s.builder.Prefix(sql, args...)
+	return s
+}
+
+// PrefixExpr adds an expression to the very beginning of the query
+func (s *QueryMapStmt) PrefixExpr(expr builder.Sqlizer) *QueryMapStmt {
+	s.builder.PrefixExpr(expr)
+	return s
+}
+
+// Distinct adds a DISTINCT clause to the query.
+func (s *QueryMapStmt) Distinct() *QueryMapStmt {
+	s.builder.Distinct()
+	return s
+}
+
+// Options adds select option to the query
+func (s *QueryMapStmt) Options(options ...string) *QueryMapStmt {
+	s.builder.Options(options...)
+	return s
+}
+
+// Columns adds result columns to the query.
+func (s *QueryMapStmt) Columns(columns ...string) *QueryMapStmt {
+	s.builder.Select(columns...)
+	return s
+}
+
+// RemoveColumns remove all columns from query.
+// Must add a new column with Column or Columns methods, otherwise
+// return a error.
+func (s *QueryMapStmt) RemoveColumns() *QueryMapStmt {
+	s.builder.RemoveColumns()
+	return s
+}
+
+// Column adds a result column to the query.
+// Unlike Columns, Column accepts args which will be bound to placeholders in
+// the columns string, for example:
+//
+//	AddColumn("IF(col IN ("+squirrel.Placeholders(3)+"), 1, 0) as col", 1, 2, 3)
+func (s *QueryMapStmt) Column(column any, args ...any) *QueryMapStmt {
+	s.builder.AddColumn(column, args...)
+	return s
+}
+
+// From sets the FROM clause of the query.
+func (s *QueryMapStmt) From(from string) *QueryMapStmt {
+	s.builder.From(from)
+	return s
+}
+
+// FromSelect sets a subquery into the FROM clause of the query.
+func (s *QueryMapStmt) FromSelect(from *builder.SelectBuilder, alias string) *QueryMapStmt {
+	s.builder.FromSelect(from, alias)
+	return s
+}
+
+// JoinClause adds a join clause to the query.
+func (s *QueryMapStmt) JoinClause(pred any, args ...any) *QueryMapStmt {
+	s.builder.JoinClause(pred, args...)
+	return s
+}
+
+// Join adds a JOIN clause to the query.
+func (s *QueryMapStmt) Join(join string, rest ...any) *QueryMapStmt {
+	s.builder.Join(join, rest...)
+	return s
+}
+
+// LeftJoin adds a LEFT JOIN clause to the query.
+func (s *QueryMapStmt) LeftJoin(join string, rest ...any) *QueryMapStmt {
+	s.builder.LeftJoin(join, rest...)
+	return s
+}
+
+// RightJoin adds a RIGHT JOIN clause to the query.
+func (s *QueryMapStmt) RightJoin(join string, rest ...any) *QueryMapStmt {
+	s.builder.RightJoin(join, rest...)
+	return s
+}
+
+// InnerJoin adds a INNER JOIN clause to the query.
+func (s *QueryMapStmt) InnerJoin(join string, rest ...any) *QueryMapStmt {
+	s.builder.InnerJoin(join, rest...)
+	return s
+}
+
+// CrossJoin adds a CROSS JOIN clause to the query.
+func (s *QueryMapStmt) CrossJoin(join string, rest ...any) *QueryMapStmt {
+	s.builder.CrossJoin(join, rest...)
+	return s
+}
+
+// Where adds an expression to the WHERE clause of the query.
+//
+// Expressions are ANDed together in the generated SQL.
+//
+// Where accepts several types for its pred argument:
+//
+// nil OR "" - ignored.
+//
+// string - SQL expression.
+// If the expression has SQL placeholders then a set of arguments must be passed
+// as well, one for each placeholder.
+//
+// map[string]any OR Eq - map of SQL expressions to values. Each key is
+// transformed into an expression like "<key> = ?", with the corresponding value
+// bound to the placeholder. If the value is nil, the expression will be "<key>
+// IS NULL". If the value is an array or slice, the expression will be "<key> IN
+// (?,?,...)", with one placeholder for each item in the value. These expressions
+// are ANDed together.
+//
+// Where will panic if pred isn't any of the above types.
+func (s *QueryMapStmt) Where(pred any, args ...any) *QueryMapStmt {
+	s.builder.Where(pred, args...)
+	return s
+}
+
+// GroupBy adds GROUP BY expressions to the query.
+func (s *QueryMapStmt) GroupBy(groupBys ...string) *QueryMapStmt {
+	s.builder.GroupBy(groupBys...)
+	return s
+}
+
+// Having adds an expression to the HAVING clause of the query.
+//
+// See Where.
+func (s *QueryMapStmt) Having(pred any, rest ...any) *QueryMapStmt {
+	s.builder.Having(pred, rest...)
+	return s
+}
+
+// OrderByClause adds ORDER BY clause to the query.
+func (s *QueryMapStmt) OrderByClause(pred any, args ...any) *QueryMapStmt {
+	s.builder.OrderByClause(pred, args...)
+	return s
+}
+
+// OrderBy adds ORDER BY expressions to the query.
+func (s *QueryMapStmt) OrderBy(orderBys ...string) *QueryMapStmt {
+	s.builder.OrderBy(orderBys...)
+	return s
+}
+
+// Limit sets a LIMIT clause on the query.
+func (s *QueryMapStmt) Limit(limit uint64) *QueryMapStmt {
+	s.builder.Limit(limit)
+	return s
+}
+
+func (s *QueryMapStmt) RemoveLimit() *QueryMapStmt {
+	s.builder.RemoveLimit()
+	return s
+}
+
+// Offset sets a OFFSET clause on the query.
+func (s *QueryMapStmt) Offset(offset uint64) *QueryMapStmt {
+	s.builder.Offset(offset)
+	return s
+}
+
+// RemoveOffset removes OFFSET clause.
+func (s *QueryMapStmt) RemoveOffset() *QueryMapStmt {
+	s.builder.RemoveOffset()
+	return s
+}
+
+// Suffix adds an expression to the end of the query
+func (s *QueryMapStmt) Suffix(sql string, args ...any) *QueryMapStmt {
+	s.builder.Suffix(sql, args...)
+	return s
+}
+
+// SuffixExpr adds an expression to the end of the query
+func (s *QueryMapStmt) SuffixExpr(expr builder.Sqlizer) *QueryMapStmt {
+	s.builder.SuffixExpr(expr)
+	return s
+}
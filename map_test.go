@@ -0,0 +1,58 @@
+package lorm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryMaps(t *testing.T) {
+	engine := newSQLiteEngine(t,
+		"CREATE TABLE report (id INTEGER PRIMARY KEY, name TEXT, score REAL, attrs JSON, raw BLOB, created_at DATETIME, price DECIMAL(10,2))",
+		"INSERT INTO report VALUES (1, 'a', 1.5, '{\"k\":[1,2]}', x'0102', '2024-01-02 03:04:05', '9.99'), (2, NULL, NULL, NULL, NULL, NULL, NULL)",
+	)
+	ctx := context.TODO()
+
+	maps, err := QueryMaps(engine).From("report").Columns("*").OrderBy("id").Find(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, maps, 2) {
+		assert.Equal(t, int64(1), maps[0]["id"])
+		assert.Equal(t, "a", maps[0]["name"])
+		assert.Equal(t, 1.5, maps[0]["score"])
+		assert.Equal(t, map[string]any{"k": []any{float64(1), float64(2)}}, maps[0]["attrs"])
+		assert.Equal(t, []byte{1, 2}, maps[0]["raw"])
+		assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), maps[0]["created_at"])
+		assert.Equal(t, map[string]any{"id": int64(2), "name": nil, "score": nil, "attrs": nil, "raw": nil, "created_at": nil, "price": nil}, maps[1])
+	}
+
+	row, ok, err := QueryMaps(engine).From("report").Columns("COUNT(*) AS total").Get(ctx)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]any{"total": int64(2)}, row)
+
+	_, ok, err = QueryMaps(engine).From("report").Columns("id").Where("id > ?", 2).Get(ctx)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	var raw []map[string]any
+	assert.NoError(t, engine.Query(ctx, NewMapScanner(&raw), "SELECT name FROM report WHERE id = ?", 1))
+	assert.Equal(t, []map[string]any{{"name": "a"}}, raw)
+
+	ids, err := QueryMaps(engine).From("report").Columns("id").OrderByClause("id DESC").Find(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"id": int64(2)}, {"id": int64(1)}}, ids)
+}
+
+func TestQueryMapsIntegerTypes(t *testing.T) {
+	engine := newSQLiteEngine(t,
+		"CREATE TABLE shape (id INT(11), total UNSIGNED BIGINT, location POINT, span INTERVAL)",
+		"INSERT INTO shape VALUES (x'37', x'38', x'312032', x'3120646179')",
+	)
+	maps, err := QueryMaps(engine).From("shape").Columns("*").Find(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"id": int64(7), "total": uint64(8), "location": "1 2", "span": "1 day"}}, maps)
+	assert.True(t, isIntegerType("INT8"))
+	assert.False(t, isIntegerType("POINT"))
+}
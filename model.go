@@ -4,11 +4,6 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
-	"slices"
-	"time"
-
-	json "github.com/bytedance/sonic"
-	"github.com/samber/lo"
 )
 
 type Table interface {
@@ -32,6 +27,15 @@ type Model interface {
 	LormModelDescriptor() *ModelDescriptor
 }
 
+// ScanDestModel is implemented by the models generated by lormgen, scanners use it instead of LormFieldMap
+// so that no map is built per row
+type ScanDestModel interface {
+	Model
+	// LormScanDest appends the pointers of the fields at the indexes of ModelDescriptor.Fields to dest,
+	// it appends nil for negative indexes
+	LormScanDest(fields []int, dest []any) []any
+}
+
 type UnimplementedModel struct{}
 
 func (u UnimplementedModel) mustEmbedUnimplementedModel() {}
@@ -41,65 +45,87 @@ type UnimplementedTable struct{}
 func (u UnimplementedTable) mustEmbedUnimplementedModel() {}
 func (u UnimplementedTable) mustEmbedUnimplementedTable() {}
 
-func ModelToInsertData[T Model](model T) (columns []string, values []any) {
-	fields, v := ModelsToInsertData([]T{model})
-	return fields, v[0]
+// ModelToInsertData is like ModelsToInsertData but returns the values of a single model
+func ModelToInsertData[T Model](engine *Engine, model T) (columns []string, values []any, err error) {
+	fields, v, err := ModelsToInsertData(engine, []T{model})
+	if err != nil {
+		return nil, nil, err
+	}
+	return fields, v[0], nil
+}
+
+// ModelsToInsertData returns the columns and the values to insert models, the values are wrapped with the codecs
+// and the converters of engine, engine can be nil to use the default codecs only,
+// it fails if a tag-declared default value can not be converted to the type of its field
+func ModelsToInsertData[T Model](engine *Engine, models []T) (columns []string, values [][]any, err error) {
+	return modelsToInsertData(engine, models)
 }
 
-func ModelsToInsertData[T Model](models []T) (columns []string, values [][]any) {
+func modelsToInsertData[T Model](engine *Engine, models []T) (columns []string, values [][]any, err error) {
 	if len(models) == 0 {
 		return
 	}
 	descriptor := models[0].LormModelDescriptor()
 	columns = descriptor.AllFields()
-	createdFields := descriptor.FlagFields(FlagCreated)
-	updatedFields := descriptor.FlagFields(FlagUpdated)
-	jsonFields := descriptor.FlagFields(FlagJson)
-	now := time.Now()
+	now := engine.now()
 	for _, model := range models {
 		fieldMap := model.LormFieldMap()
-		values = append(values, lo.Map(columns, func(field string, _ int) any {
-			if slices.Contains(createdFields, field) || slices.Contains(updatedFields, field) {
-				fillCurrentTime(fieldMap[field], now)
+		row := make([]any, 0, len(descriptor.Fields))
+		for _, field := range descriptor.Fields {
+			ptr := fieldMap[field.DBField]
+			if field.Flag.HasFlag(FlagCreated | FlagUpdated) {
+				fillFieldTime(field, ptr, now)
 			}
-			if slices.Contains(jsonFields, field) {
-				return NewJSONFieldWrapper(fieldMap[field])
+			if field.Flag.HasFlag(FlagDefault) {
+				value, ok, err := engine.applyDefault(ptr, field)
+				if err != nil {
+					return nil, nil, err
+				}
+				if ok {
+					row = append(row, value)
+					continue
+				}
 			}
-			return fieldMap[field]
-		}))
+			row = append(row, engine.wrapField(field, ptr))
+		}
+		values = append(values, row)
 	}
+	columns, values = omitDBDefaults(columns, values)
 	return
 }
 
+// JSONFieldWrapper encodes a value as JSON with the json codec looked up without an engine, that is the built-in one,
+// fields flagged json are wrapped in a CodecFieldWrapper with the json codec of the engine instead
 type JSONFieldWrapper struct {
-	v any
+	v     any
+	codec Codec
 }
 
 func NewJSONFieldWrapper(v any) *JSONFieldWrapper {
-	return &JSONFieldWrapper{v: v}
+	return &JSONFieldWrapper{v: v, codec: (*Engine)(nil).codec("json")}
 }
 
 func (s *JSONFieldWrapper) Value() (driver.Value, error) {
 	if s.v == nil {
 		return nil, nil
 	}
-	return json.Marshal(s.v)
+	return s.codec.Marshal(s.v)
 }
 
 func (s *JSONFieldWrapper) String() string {
 	if s.v == nil {
 		return ""
 	}
-	str, _ := json.MarshalString(s.v)
-	return str
+	data, _ := s.codec.Marshal(s.v)
+	return string(data)
 }
 
 func (s *JSONFieldWrapper) MarshalJSON() ([]byte, error) {
-	return json.Marshal(s.v)
+	return s.codec.Marshal(s.v)
 }
 
 func (s *JSONFieldWrapper) UnmarshalJSON(data []byte) error {
-	return json.Unmarshal(data, s.v)
+	return s.codec.Unmarshal(data, s.v)
 }
 
 func (s *JSONFieldWrapper) Scan(src any) error {
@@ -108,9 +134,9 @@ func (s *JSONFieldWrapper) Scan(src any) error {
 	}
 	switch v := src.(type) {
 	case []byte:
-		return json.Unmarshal(v, s.v)
+		return s.codec.Unmarshal(v, s.v)
 	case string:
-		return json.Unmarshal([]byte(v), s.v)
+		return s.codec.Unmarshal([]byte(v), s.v)
 	case nil:
 		return nil
 	}
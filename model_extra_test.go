@@ -7,7 +7,8 @@ import (
 
 func TestModelToInsertData(t *testing.T) {
 	m := &Test{}
-	cols, vals := ModelToInsertData(m)
+	cols, vals, err := ModelToInsertData(nil, m)
+	assert.NoError(t, err)
 	assert.NotEmpty(t, cols)
 	assert.Len(t, cols, len(m.Fields().All()))
 	assert.NotEmpty(t, vals)
@@ -6,6 +6,12 @@ import (
 	json "github.com/bytedance/sonic"
 )
 
+func init() {
+	Register(
+		new(Test),
+	)
+}
+
 func (m *Test) TableName() string {
 	return "test"
 }
@@ -38,6 +44,54 @@ func (m *Test) LormFieldMap() map[string]any {
 	}
 }
 
+func (m *Test) LormScanDest(fields []int, dest []any) []any {
+	for _, i := range fields {
+		switch i {
+		case 0:
+			dest = append(dest, &m.ID)
+		case 1:
+			dest = append(dest, &m.Int)
+		case 2:
+			dest = append(dest, &m.IntP)
+		case 3:
+			dest = append(dest, &m.Bool)
+		case 4:
+			dest = append(dest, &m.BoolP)
+		case 5:
+			dest = append(dest, &m.Str)
+		case 6:
+			dest = append(dest, &m.StrP)
+		case 7:
+			dest = append(dest, &m.Timestamp)
+		case 8:
+			dest = append(dest, &m.TimestampP)
+		case 9:
+			dest = append(dest, &m.Datetime)
+		case 10:
+			dest = append(dest, &m.DatetimeP)
+		case 11:
+			dest = append(dest, &m.Decimal)
+		case 12:
+			dest = append(dest, &m.DecimalP)
+		case 13:
+			dest = append(dest, &m.IntSlice)
+		case 14:
+			dest = append(dest, &m.IntSliceP)
+		case 15:
+			dest = append(dest, &m.Struct)
+		case 16:
+			dest = append(dest, &m.StructP)
+		case 17:
+			dest = append(dest, &m.CreatedAt)
+		case 18:
+			dest = append(dest, &m.UpdatedAt)
+		default:
+			dest = append(dest, nil)
+		}
+	}
+	return dest
+}
+
 func (m *Test) LormModelDescriptor() *ModelDescriptor {
 	return _lorm_file_test_model_model_descriptor_map["Test"]
 }
@@ -193,7 +247,7 @@ func (f *Test_Fields) All() []string {
 	}
 }
 
-const _lorm_file_test_model_raw = `{"Path":"test/model.go","LormImportAlias":"lorm","Package":"test","Imports":[{"Path":"\"time\"","Alias":""},{"Path":"\"github.com/shopspring/decimal\"","Alias":""},{"Path":"\"github.com/yvvlee/lorm\"","Alias":""}],"Structs":[{"Name":"Test","TableName":"test","Fields":[{"Name":"ID","FullName":"ID","DBField":"id","Type":"uint64","Flag":3},{"Name":"Int","FullName":"Int","DBField":"index","Type":"int","Flag":0},{"Name":"IntP","FullName":"IntP","DBField":"int_p","Type":"*int","Flag":0},{"Name":"Bool","FullName":"Bool","DBField":"bool","Type":"bool","Flag":0},{"Name":"BoolP","FullName":"BoolP","DBField":"bool_p","Type":"*bool","Flag":0},{"Name":"Str","FullName":"Str","DBField":"str","Type":"string","Flag":0},{"Name":"StrP","FullName":"StrP","DBField":"str_p","Type":"*string","Flag":0},{"Name":"Timestamp","FullName":"Timestamp","DBField":"timestamp","Type":"time.Time","Flag":0},{"Name":"TimestampP","FullName":"TimestampP","DBField":"timestamp_p","Type":"*time.Time","Flag":0},{"Name":"Datetime","FullName":"Datetime","DBField":"datetime","Type":"time.Time","Flag":0},{"Name":"DatetimeP","FullName":"DatetimeP","DBField":"datetime_p","Type":"*time.Time","Flag":0},{"Name":"Decimal","FullName":"Decimal","DBField":"decimal","Type":"decimal.Decimal","Flag":0},{"Name":"DecimalP","FullName":"DecimalP","DBField":"decimal_p","Type":"*decimal.Decimal","Flag":0},{"Name":"IntSlice","FullName":"IntSlice","DBField":"int_slice","Type":"[]int","Flag":4},{"Name":"IntSliceP","FullName":"IntSliceP","DBField":"int_slice_p","Type":"*[]int","Flag":4},{"Name":"Struct","FullName":"Struct","DBField":"struct","Type":"Sub","Flag":4},{"Name":"StructP","FullName":"StructP","DBField":"struct_p","Type":"*Sub","Flag":4},{"Name":"CreatedAt","FullName":"CreatedAt","DBField":"created_at","Type":"time.Time","Flag":8},{"Name":"UpdatedAt","FullName":"UpdatedAt","DBField":"updated_at","Type":"time.Time","Flag":16}]}]}`
+const _lorm_file_test_model_raw = `{"Path":"test/model.go","LormImportAlias":"lorm","Package":"test","Imports":[{"Path":"\"time\"","Alias":""},{"Path":"\"github.com/shopspring/decimal\"","Alias":""},{"Path":"\"github.com/yvvlee/lorm\"","Alias":""}],"Structs":[{"Name":"Test","TableName":"test","Fields":[{"Name":"ID","FullName":"ID","DBField":"id","Type":"uint64","Flag":3},{"Name":"Int","FullName":"Int","DBField":"index","Type":"int","Flag":0},{"Name":"IntP","FullName":"IntP","DBField":"int_p","Type":"*int","Flag":2048},{"Name":"Bool","FullName":"Bool","DBField":"bool","Type":"bool","Flag":0},{"Name":"BoolP","FullName":"BoolP","DBField":"bool_p","Type":"*bool","Flag":2048},{"Name":"Str","FullName":"Str","DBField":"str","Type":"string","Flag":0},{"Name":"StrP","FullName":"StrP","DBField":"str_p","Type":"*string","Flag":2048},{"Name":"Timestamp","FullName":"Timestamp","DBField":"timestamp","Type":"time.Time","Flag":0},{"Name":"TimestampP","FullName":"TimestampP","DBField":"timestamp_p","Type":"*time.Time","Flag":2048},{"Name":"Datetime","FullName":"Datetime","DBField":"datetime","Type":"time.Time","Flag":0},{"Name":"DatetimeP","FullName":"DatetimeP","DBField":"datetime_p","Type":"*time.Time","Flag":2048},{"Name":"Decimal","FullName":"Decimal","DBField":"decimal","Type":"decimal.Decimal","Flag":0},{"Name":"DecimalP","FullName":"DecimalP","DBField":"decimal_p","Type":"*decimal.Decimal","Flag":2048},{"Name":"IntSlice","FullName":"IntSlice","DBField":"int_slice","Type":"[]int","Flag":4},{"Name":"IntSliceP","FullName":"IntSliceP","DBField":"int_slice_p","Type":"*[]int","Flag":2052},{"Name":"Struct","FullName":"Struct","DBField":"struct","Type":"Sub","Flag":4},{"Name":"StructP","FullName":"StructP","DBField":"struct_p","Type":"*Sub","Flag":2052},{"Name":"CreatedAt","FullName":"CreatedAt","DBField":"created_at","Type":"time.Time","Flag":8},{"Name":"UpdatedAt","FullName":"UpdatedAt","DBField":"updated_at","Type":"time.Time","Flag":16}]}]}`
 
 var _lorm_file_test_model_model_descriptor_map = func() map[string]*ModelDescriptor {
 	var file FileDescriptor
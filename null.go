@@ -0,0 +1,168 @@
+package lorm
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/spf13/cast"
+)
+
+// Null represents a value of T that may be NULL, it implements sql.Scanner, driver.Valuer and JSON,
+// NULL is encoded as JSON null, V is encoded with the built-in json codec since JSON methods have no engine
+type Null[T any] struct {
+	V     T
+	Valid bool
+}
+
+// NewNull returns a valid Null of v
+func NewNull[T any](v T) Null[T] {
+	return Null[T]{V: v, Valid: true}
+}
+
+// Ptr returns a pointer to V, or nil if n is NULL
+func (n Null[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	return &n.V
+}
+
+func (n *Null[T]) Scan(src any) error {
+	var v sql.Null[T]
+	err := v.Scan(src)
+	n.V, n.Valid = v.V, v.Valid
+	return err
+}
+
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(n.V)
+}
+
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	// Null values are encoded outside of an engine, the lookup falls back to the built-in json codec
+	return (*Engine)(nil).codec("json").Marshal(n.V)
+}
+
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		var zero T
+		n.V, n.Valid = zero, false
+		return nil
+	}
+	if err := (*Engine)(nil).codec("json").Unmarshal(data, &n.V); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// NullZeroFieldWrapper maps the zero value of a field to NULL when it is written and NULL to the zero value when it is scanned,
+// v is the value bound or scanned when the field is not zero or NULL, it is the field pointer or another wrapper of it
+type NullZeroFieldWrapper struct {
+	ptr any
+	v   any
+}
+
+func NewNullZeroFieldWrapper(ptr any, v any) *NullZeroFieldWrapper {
+	return &NullZeroFieldWrapper{ptr: ptr, v: v}
+}
+
+func (s *NullZeroFieldWrapper) Value() (driver.Value, error) {
+	if reflect.ValueOf(s.ptr).Elem().IsZero() {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(s.v)
+}
+
+func (s *NullZeroFieldWrapper) Scan(src any) error {
+	if src == nil {
+		reflect.ValueOf(s.ptr).Elem().SetZero()
+		return nil
+	}
+	if scanner, ok := s.v.(sql.Scanner); ok {
+		return scanner.Scan(src)
+	}
+	return assignValue(reflect.ValueOf(s.v).Elem(), src)
+}
+
+// assignValue stores a non-NULL column value into dest, it supports the basic kinds, time.Time and pointers to them
+func assignValue(dest reflect.Value, src any) error {
+	if dest.Kind() == reflect.Pointer {
+		value := reflect.New(dest.Type().Elem())
+		if err := assignValue(value.Elem(), src); err != nil {
+			return err
+		}
+		dest.Set(value)
+		return nil
+	}
+	if b, ok := src.([]byte); ok {
+		if dest.Kind() == reflect.Slice && dest.Type().Elem().Kind() == reflect.Uint8 {
+			dest.SetBytes(append([]byte(nil), b...))
+			return nil
+		}
+		src = string(b)
+	}
+	if dest.Addr().Type().Implements(scannerType) {
+		return dest.Addr().Interface().(sql.Scanner).Scan(src)
+	}
+	var (
+		v   any
+		err error
+	)
+	switch {
+	case dest.Type() == reflect.TypeFor[time.Time]():
+		v, err = cast.ToTimeE(src)
+	case dest.Kind() == reflect.String:
+		v, err = cast.ToStringE(src)
+	case dest.Kind() == reflect.Bool:
+		v, err = cast.ToBoolE(src)
+	case dest.CanInt():
+		v, err = cast.ToInt64E(src)
+	case dest.CanUint():
+		v, err = cast.ToUint64E(src)
+	case dest.CanFloat():
+		v, err = cast.ToFloat64E(src)
+	default:
+		return fmt.Errorf("lorm: cannot assign %T to %s", src, dest.Type())
+	}
+	if err != nil {
+		return err
+	}
+	dest.Set(reflect.ValueOf(v).Convert(dest.Type()))
+	return nil
+}
+
+// isNullField reports whether the field pointed by ptr stores NULL: a nil pointer, a zero nullzero field,
+// or a nullable field whose driver.Valuer returns nil, eg: an invalid Null[T]
+func isNullField(field *FieldDescriptor, ptr any) bool {
+	if isNilPointer(ptr) {
+		return true
+	}
+	if field.Flag.HasFlag(FlagNullZero) && reflect.ValueOf(ptr).Elem().IsZero() {
+		return true
+	}
+	if !field.Flag.HasFlag(FlagNullable) {
+		return false
+	}
+	valuer, ok := ptr.(driver.Valuer)
+	if !ok {
+		return false
+	}
+	value, err := valuer.Value()
+	return err == nil && value == nil
+}
+
+// isNilPointer reports whether ptr points to a nil pointer, eg: the pointer of a nil *string field
+func isNilPointer(ptr any) bool {
+	value := reflect.ValueOf(ptr)
+	return value.Kind() == reflect.Pointer && !value.IsNil() && value.Elem().Kind() == reflect.Pointer && value.Elem().IsNil()
+}
@@ -0,0 +1,78 @@
+package lorm
+
+import (
+	"context"
+	"testing"
+
+	json "github.com/bytedance/sonic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullJSON(t *testing.T) {
+	data, err := json.Marshal([]Null[int]{NewNull(1), {}})
+	assert.NoError(t, err)
+	assert.Equal(t, "[1,null]", string(data))
+
+	var values []Null[int]
+	assert.NoError(t, json.Unmarshal([]byte("[null,2]"), &values))
+	assert.Equal(t, []Null[int]{{}, NewNull(2)}, values)
+	assert.Nil(t, values[0].Ptr())
+	assert.Equal(t, 2, *values[1].Ptr())
+}
+
+func TestNullValue(t *testing.T) {
+	v, err := Null[int]{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+	v, err = NewNull(3).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), v)
+}
+
+func TestIsNullField(t *testing.T) {
+	m := &nullModel{Phone: "p"}
+	fieldMap := m.LormFieldMap()
+	fields := descriptorFieldMap(m.LormModelDescriptor())
+	assert.True(t, isNullField(fields["nickname"], fieldMap["nickname"]))
+	assert.True(t, isNullField(fields["score"], fieldMap["score"]))
+	assert.True(t, isNullField(fields["age"], fieldMap["age"]))
+	assert.False(t, isNullField(fields["phone"], fieldMap["phone"]))
+	assert.False(t, isNullField(fields["id"], fieldMap["id"]))
+
+	m.Nickname, m.Score = NewNull("n"), new(int)
+	assert.False(t, isNullField(fields["nickname"], fieldMap["nickname"]))
+	assert.False(t, isNullField(fields["score"], fieldMap["score"]))
+}
+
+func TestNullFields(t *testing.T) {
+	engine := newSQLiteEngine(t, "CREATE TABLE null_model (id INTEGER PRIMARY KEY, nickname TEXT, score INTEGER, extra TEXT, phone TEXT, age INTEGER)")
+	ctx := context.TODO()
+
+	score := 5
+	_, err := InsertAll(ctx, engine, []*nullModel{
+		{ID: 1},
+		{ID: 2, Nickname: NewNull("nick"), Score: &score, Extra: &Sub{ID: 1, Name: "n"}, Phone: "123", Age: 20},
+	})
+	assert.NoError(t, err)
+
+	nulls, _, err := QueryCol[int](engine).From("null_model").
+		Columns("COUNT(*)").
+		Where("nickname IS NULL AND score IS NULL AND extra IS NULL AND phone IS NULL AND age IS NULL").
+		Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, nulls)
+
+	found, err := Query[*nullModel](engine).OrderBy("id").Find(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, found, 2) {
+		assert.Equal(t, &nullModel{ID: 1}, found[0])
+		assert.Equal(t, &nullModel{ID: 2, Nickname: NewNull("nick"), Score: &score, Extra: &Sub{ID: 1, Name: "n"}, Phone: "123", Age: 20}, found[1])
+	}
+
+	// NULL resets fields scanned into a reused model
+	m := found[1]
+	m.ID = 1
+	err = engine.session(ctx).Query(ctx, NewModelScanner(m), "SELECT * FROM null_model WHERE id = 1")
+	assert.NoError(t, err)
+	assert.Equal(t, &nullModel{ID: 1}, m)
+}
@@ -0,0 +1,188 @@
+package lorm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/spf13/cast"
+)
+
+// PageResult is a page returned by Paginate
+type PageResult[T any] struct {
+	Items []T
+	Page  uint64
+	Size  uint64
+	// Total is the number of models matched by the query, it is zero if Counted is false
+	Total uint64
+	// Counted reports whether the query was counted, see WithoutCount
+	Counted bool
+	// Estimated reports whether Total is the estimate of the query planner, see WithEstimatedCount
+	Estimated bool
+	// HasNext reports whether there are models after the page
+	HasNext bool
+}
+
+type pageOptions struct {
+	skipCount  bool
+	concurrent bool
+	estimated  bool
+}
+
+type PageOption func(*pageOptions)
+
+// WithoutCount skips the count of the query, HasNext is computed by fetching one more model than the size of the page
+func WithoutCount() PageOption {
+	return func(o *pageOptions) {
+		o.skipCount = true
+	}
+}
+
+// WithConcurrentCount runs the count and the query of the page concurrently,
+// they run one after the other in a transaction since a transaction can not run concurrent queries
+func WithConcurrentCount() PageOption {
+	return func(o *pageOptions) {
+		o.concurrent = true
+	}
+}
+
+// WithEstimatedCount uses the row estimate of the query planner as the total, eg: for very large tables,
+// it is supported on MySQL and PostgreSQL, an exact count is used on other databases
+func WithEstimatedCount() PageOption {
+	return func(o *pageOptions) {
+		o.estimated = true
+	}
+}
+
+// Paginate returns the models of page, which starts at 1, the query itself is left unchanged, eg:
+//
+//	result, err := Query[*User](engine).Where(...).OrderBy("id").Paginate(ctx, 2, 20, lorm.WithConcurrentCount())
+func (s *QueryModelStmt[T]) Paginate(ctx context.Context, page, size uint64, opts ...PageOption) (*PageResult[T], error) {
+	if size == 0 {
+		return nil, errors.New("size can not be zero")
+	}
+	if page == 0 {
+		page = 1
+	}
+	var o pageOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	result := &PageResult[T]{Page: page, Size: size}
+	offset := (page - 1) * size
+	// HasNext is computed from an exact count, otherwise by fetching one more model
+	exact := !o.skipCount && !o.estimated
+	limit := size
+	if !exact {
+		limit = size + 1
+	}
+	pageStmt := s.clone()
+	pageStmt.builder.Limit(limit).Offset(offset)
+
+	count := func() error {
+		if o.skipCount {
+			return nil
+		}
+		if o.estimated {
+			total, ok, err := s.estimateCount(ctx)
+			if err != nil {
+				return err
+			}
+			if ok {
+				result.Total, result.Counted, result.Estimated = total, true, true
+				return nil
+			}
+		}
+		total, err := s.Count(ctx)
+		if err != nil {
+			return err
+		}
+		result.Total, result.Counted = total, true
+		return nil
+	}
+	var items []T
+	var err error
+	if o.concurrent && !s.engine.inTX(ctx) {
+		var countErr error
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			countErr = count()
+		}()
+		items, err = pageStmt.Find(ctx)
+		wg.Wait()
+		if err = errors.Join(countErr, err); err != nil {
+			return nil, err
+		}
+	} else {
+		if err = count(); err != nil {
+			return nil, err
+		}
+		if exact && (result.Total == 0 || offset >= result.Total) {
+			return result, nil
+		}
+		if items, err = pageStmt.Find(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if exact {
+		result.HasNext = offset+uint64(len(items)) < result.Total
+	} else if uint64(len(items)) > size {
+		result.HasNext = true
+		items = items[:size]
+	}
+	result.Items = items
+	return result, nil
+}
+
+// estimateCount returns the row estimate of the query planner, it returns false if the database is not supported
+func (s *QueryModelStmt[T]) estimateCount(ctx context.Context) (uint64, bool, error) {
+	query, args, err := s.builder.Clone().RemoveOrderBy().RemoveLimit().RemoveOffset().ToSql()
+	if err != nil {
+		return 0, false, err
+	}
+	switch s.engine.config.driverName {
+	case "mysql":
+		var plans []map[string]any
+		if err = s.engine.Query(ctx, NewMapScanner(&plans), "EXPLAIN "+query, args...); err != nil {
+			return 0, false, err
+		}
+		if len(plans) == 0 {
+			return 0, false, errors.New("lorm: EXPLAIN returned no rows")
+		}
+		rows, err := cast.ToUint64E(plans[0]["rows"])
+		return rows, err == nil, err
+	case "postgres", "pgx", "pq-timeouts", "cloudsqlpostgres", "nrpostgres", "cockroach":
+		var plans []string
+		if err = s.engine.Query(ctx, NewColsScanner(&plans), "EXPLAIN (FORMAT JSON) "+query, args...); err != nil {
+			return 0, false, err
+		}
+		if len(plans) == 0 {
+			return 0, false, errors.New("lorm: EXPLAIN returned no rows")
+		}
+		rows, err := postgresPlanRows(plans[0])
+		return rows, err == nil, err
+	default:
+		return 0, false, nil
+	}
+}
+
+// postgresPlanRows returns the estimated rows of the top node of a plan of EXPLAIN (FORMAT JSON),
+// the plan is decoded with encoding/json since it is not a value of a model
+func postgresPlanRows(plan string) (uint64, error) {
+	var plans []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		}
+	}
+	if err := json.Unmarshal([]byte(plan), &plans); err != nil {
+		return 0, fmt.Errorf("lorm: decode plan: %w", err)
+	}
+	if len(plans) == 0 {
+		return 0, errors.New("lorm: empty plan")
+	}
+	return uint64(plans[0].Plan.Rows), nil
+}
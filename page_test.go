@@ -0,0 +1,71 @@
+package lorm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginate(t *testing.T) {
+	engine := newPlanEngine(t, 7)
+	ctx := context.TODO()
+	query := Query[*planModel](engine).Where("id > ?", 1).OrderBy("id")
+
+	list, total, err := query.Page(ctx, 2, 4)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 6, total)
+	assert.Equal(t, []int64{6, 7}, planIDs(list))
+	// the statement is reused
+	list, total, err = query.Page(ctx, 1, 4)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 6, total)
+	assert.Equal(t, []int64{2, 3, 4, 5}, planIDs(list))
+
+	result, err := query.Paginate(ctx, 1, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, &PageResult[*planModel]{Items: result.Items, Page: 1, Size: 4, Total: 6, Counted: true, HasNext: true}, result)
+
+	result, err = query.Paginate(ctx, 0, 3, WithoutCount())
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 3, 4}, planIDs(result.Items))
+	assert.EqualValues(t, 1, result.Page)
+	assert.False(t, result.Counted)
+	assert.True(t, result.HasNext)
+	result, err = query.Paginate(ctx, 2, 3, WithoutCount())
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{5, 6, 7}, planIDs(result.Items))
+	assert.False(t, result.HasNext)
+
+	result, err = query.Paginate(ctx, 2, 4, WithConcurrentCount())
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{6, 7}, planIDs(result.Items))
+	assert.EqualValues(t, 6, result.Total)
+	assert.False(t, result.HasNext)
+
+	// sqlite has no estimate, the exact count is used
+	result, err = query.Paginate(ctx, 1, 6, WithEstimatedCount())
+	assert.NoError(t, err)
+	assert.EqualValues(t, 6, result.Total)
+	assert.False(t, result.Estimated)
+	assert.False(t, result.HasNext)
+
+	err = engine.TX(ctx, func(ctx context.Context) error {
+		result, err = query.Paginate(ctx, 3, 2, WithConcurrentCount())
+		return err
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{6, 7}, planIDs(result.Items))
+	assert.EqualValues(t, 6, result.Total)
+
+	_, err = query.Paginate(ctx, 1, 0)
+	assert.EqualError(t, err, "size can not be zero")
+}
+
+func TestPostgresPlanRows(t *testing.T) {
+	rows, err := postgresPlanRows(`[{"Plan": {"Node Type": "Seq Scan", "Plan Rows": 1520, "Plan Width": 4}}]`)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1520, rows)
+	_, err = postgresPlanRows(`[]`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package lorm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// Pluck runs the query selecting only column into []V, eg: Pluck[*User, string](ctx, Query[*User](engine).Where(...), "email"),
+// the query itself is left unchanged
+func Pluck[T Model, V any](ctx context.Context, s *QueryModelStmt[T], column string) ([]V, error) {
+	stmt := QueryCol[V](s.engine)
+	stmt.builder = s.builder.Clone().Select(column)
+	return stmt.Find(ctx)
+}
+
+// FindMap runs the query and returns the models keyed by the value of column, eg: FindMap[int64](ctx, Query[*User](engine), "id"),
+// a later model replaces an earlier one of the same key, models whose key is NULL are skipped unless K is a pointer
+func FindMap[K comparable, T Model](ctx context.Context, s *QueryModelStmt[T], column string) (map[K]T, error) {
+	models, err := s.Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[K]T, len(models))
+	for _, model := range models {
+		key, ok, err := columnKey[K](model, column)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result[key] = model
+		}
+	}
+	return result, nil
+}
+
+// GroupMap runs the query and groups the models by the value of column in the order of the query,
+// models whose key is NULL are skipped unless K is a pointer
+func GroupMap[K comparable, T Model](ctx context.Context, s *QueryModelStmt[T], column string) (map[K][]T, error) {
+	models, err := s.Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[K][]T)
+	for _, model := range models {
+		key, ok, err := columnKey[K](model, column)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result[key] = append(result[key], model)
+		}
+	}
+	return result, nil
+}
+
+// columnKey returns the value of column in model as K, pointers are dereferenced unless they are assignable to K,
+// it returns false if the value is NULL
+func columnKey[K comparable](model Model, column string) (K, bool, error) {
+	var key K
+	ptr, ok := model.LormFieldMap()[column]
+	if !ok {
+		return key, false, fmt.Errorf("lorm: %s has no field %s", model.LormModelDescriptor().Name, column)
+	}
+	typ := reflect.TypeFor[K]()
+	value := reflect.ValueOf(ptr).Elem()
+	for !value.Type().AssignableTo(typ) && value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return key, false, nil
+		}
+		value = value.Elem()
+	}
+	switch {
+	case value.Type().AssignableTo(typ):
+		reflect.ValueOf(&key).Elem().Set(value)
+	case convertible(value, typ):
+		reflect.ValueOf(&key).Elem().Set(value.Convert(typ))
+	default:
+		return key, false, fmt.Errorf("lorm: field %s of type %s can not be a key of type %s", column, value.Type(), typ)
+	}
+	return key, true, nil
+}
@@ -0,0 +1,67 @@
+package lorm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluck(t *testing.T) {
+	engine := newPlanEngine(t, 5)
+	ctx := context.TODO()
+
+	query := Query[*planModel](engine).Where("id > ?", 2).OrderBy("id DESC")
+	names, err := Pluck[*planModel, string](ctx, query, "name")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"n5", "n4", "n3"}, names)
+	ids, err := Pluck[*planModel, int](ctx, query, "id")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{5, 4, 3}, ids)
+
+	// the query still selects models
+	list, err := query.Find(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, list, 3) {
+		assert.Equal(t, "n5", list[0].Name)
+	}
+}
+
+func TestFindMap(t *testing.T) {
+	engine := newRowsEngine(t)
+	ctx := context.TODO()
+	_, err := engine.Exec(ctx, "UPDATE null_model SET score = id % 3, age = id WHERE id <= 6")
+	assert.NoError(t, err)
+
+	byID, err := FindMap[int](ctx, Query[*nullModel](engine).Where("id <= ?", 3), "id")
+	assert.NoError(t, err)
+	assert.Len(t, byID, 3)
+	assert.EqualValues(t, 2, byID[2].ID)
+
+	// NULL scores are skipped and the pointer is dereferenced
+	groups, err := GroupMap[int](ctx, Query[*nullModel](engine).OrderBy("id"), "score")
+	assert.NoError(t, err)
+	assert.Len(t, groups, 3)
+	assert.Equal(t, []int64{3, 6}, modelIDs(groups[0]))
+	assert.Equal(t, []int64{1, 4}, modelIDs(groups[1]))
+	assert.Equal(t, []int64{2, 5}, modelIDs(groups[2]))
+
+	// pointer keys keep NULL, the pointers of different models are distinct keys
+	byScore, err := FindMap[*int](ctx, Query[*nullModel](engine).OrderBy("id"), "score")
+	assert.NoError(t, err)
+	assert.Len(t, byScore, 7)
+	assert.EqualValues(t, 10, byScore[nil].ID)
+
+	_, err = FindMap[int](ctx, Query[*nullModel](engine), "missing")
+	assert.EqualError(t, err, "lorm: nullModel has no field missing")
+	_, err = GroupMap[string](ctx, Query[*nullModel](engine), "age")
+	assert.EqualError(t, err, "lorm: field age of type int can not be a key of type string")
+}
+
+func modelIDs(models []*nullModel) []int64 {
+	ids := make([]int64, 0, len(models))
+	for _, model := range models {
+		ids = append(ids, model.ID)
+	}
+	return ids
+}
@@ -0,0 +1,80 @@
+package lorm
+
+import (
+	"reflect"
+	"sync"
+)
+
+// registry stores the models registered by the init functions generated by lormgen
+var registry = struct {
+	sync.RWMutex
+	models  []Model
+	byType  map[reflect.Type]Model
+	byTable map[string]Table
+}{
+	byType:  make(map[reflect.Type]Model),
+	byTable: make(map[string]Table),
+}
+
+// Register adds models to the global registry, it is called by the init functions generated by lormgen,
+// registering a type twice is a no-op, and a table name keeps the first model registered with it
+func Register(models ...Model) {
+	registry.Lock()
+	defer registry.Unlock()
+	for _, model := range models {
+		typ := reflect.TypeOf(model)
+		if _, ok := registry.byType[typ]; ok {
+			continue
+		}
+		registry.byType[typ] = model
+		registry.models = append(registry.models, model)
+		if table, ok := model.(Table); ok {
+			if _, ok := registry.byTable[table.TableName()]; !ok {
+				registry.byTable[table.TableName()] = table
+			}
+		}
+	}
+}
+
+// Models returns all registered models in registration order, the models are prototypes and must not be modified
+func Models() []Model {
+	registry.RLock()
+	defer registry.RUnlock()
+	return append([]Model(nil), registry.models...)
+}
+
+// DescriptorOf returns the descriptor of the registered model type, typ can be the struct type or the pointer to it
+func DescriptorOf(typ reflect.Type) (*ModelDescriptor, bool) {
+	if typ.Kind() != reflect.Pointer {
+		typ = reflect.PointerTo(typ)
+	}
+	registry.RLock()
+	model, ok := registry.byType[typ]
+	registry.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return model.LormModelDescriptor(), true
+}
+
+// DescriptorByTable returns the descriptor of the model registered with the table name
+func DescriptorByTable(table string) (*ModelDescriptor, bool) {
+	registry.RLock()
+	model, ok := registry.byTable[table]
+	registry.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return model.LormModelDescriptor(), true
+}
+
+// NewModel returns a new instance of the model registered with the table name
+func NewModel(table string) (Table, bool) {
+	registry.RLock()
+	model, ok := registry.byTable[table]
+	registry.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return model.New().(Table), true
+}
@@ -0,0 +1,40 @@
+package lorm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	// Test is registered by the generated init function
+	assert.Contains(t, Models(), Model(new(Test)))
+
+	descriptor, ok := DescriptorByTable("test")
+	assert.True(t, ok)
+	assert.Equal(t, new(Test).LormModelDescriptor(), descriptor)
+
+	descriptor, ok = DescriptorOf(reflect.TypeFor[Test]())
+	assert.True(t, ok)
+	assert.Equal(t, "Test", descriptor.Name)
+	_, ok = DescriptorOf(reflect.TypeFor[*Test]())
+	assert.True(t, ok)
+
+	model, ok := NewModel("test")
+	assert.True(t, ok)
+	assert.IsType(t, new(Test), model)
+
+	Register(new(relUser), new(relUser))
+	count := len(Models())
+	Register(new(relUser))
+	assert.Len(t, Models(), count)
+	descriptor, ok = DescriptorByTable("rel_user")
+	assert.True(t, ok)
+	assert.Equal(t, "relUser", descriptor.Name)
+
+	_, ok = NewModel("missing")
+	assert.False(t, ok)
+	_, ok = DescriptorOf(reflect.TypeFor[Sub]())
+	assert.False(t, ok)
+}
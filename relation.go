@@ -0,0 +1,183 @@
+package lorm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/samber/lo"
+	"github.com/yvvlee/lorm/builder"
+)
+
+// preload loads the relations names of models, every relation is loaded by one batched IN query
+func preload[T Model](ctx context.Context, engine *Engine, models []T, names []string) error {
+	if len(models) == 0 || len(names) == 0 {
+		return nil
+	}
+	parents := lo.Map(models, func(model T, _ int) Model {
+		return model
+	})
+	descriptor := models[0].LormModelDescriptor()
+	for _, name := range names {
+		relation := descriptor.Relation(name)
+		if relation == nil {
+			return fmt.Errorf("lorm: %s has no relation %q", descriptor.Name, name)
+		}
+		if err := loadRelation(ctx, engine, parents, relation); err != nil {
+			return fmt.Errorf("lorm: preload %s.%s: %w", descriptor.Name, name, err)
+		}
+	}
+	return nil
+}
+
+// loadRelation queries the related models of parents and stores them into the relation field
+func loadRelation(ctx context.Context, engine *Engine, parents []Model, relation *RelationDescriptor) error {
+	field, related, err := relationField(parents[0], relation)
+	if err != nil {
+		return err
+	}
+	var parentKey, relatedKey string
+	switch relation.Kind {
+	case RelHasOne, RelHasMany:
+		parentKey, relatedKey = relation.References, relation.ForeignKey
+		if parentKey == "" {
+			if parentKey, err = primaryKey(parents[0].LormModelDescriptor()); err != nil {
+				return err
+			}
+		}
+	case RelBelongsTo:
+		parentKey, relatedKey = relation.ForeignKey, relation.References
+		if relatedKey == "" {
+			if relatedKey, err = primaryKey(related.LormModelDescriptor()); err != nil {
+				return err
+			}
+		}
+	case RelManyToMany:
+		return loadManyToMany(ctx, engine, parents, relation, field, related)
+	default:
+		return fmt.Errorf("unsupported relation kind %q", relation.Kind)
+	}
+
+	keys := relationKeys(parents, parentKey)
+	var children []Model
+	if len(keys) > 0 {
+		in := builder.In{Col: engine.Escaper().Escape(relatedKey), Val: keys}
+		if children, err = queryRelated(ctx, engine, related, in); err != nil {
+			return err
+		}
+	}
+	groups := lo.GroupBy(children, func(child Model) string {
+		key, _ := relationKey(child.LormFieldMap()[relatedKey])
+		return key
+	})
+	for _, parent := range parents {
+		var matched []Model
+		if key, ok := relationKey(parent.LormFieldMap()[parentKey]); ok {
+			matched = groups[key]
+		}
+		setRelated(reflect.ValueOf(parent).Elem().FieldByIndex(field.Index), matched)
+	}
+	return nil
+}
+
+// relationField returns the struct field of relation and a prototype of the related model
+func relationField(model Model, relation *RelationDescriptor) (reflect.StructField, Table, error) {
+	field, ok := reflect.TypeOf(model).Elem().FieldByName(relation.Name)
+	if !ok {
+		return field, nil, fmt.Errorf("field %s not found", relation.Name)
+	}
+	typ := field.Type
+	if typ.Kind() == reflect.Slice {
+		typ = typ.Elem()
+	}
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	related, ok := reflect.New(typ).Interface().(Table)
+	if !ok {
+		return field, nil, fmt.Errorf("%s is not a table", typ)
+	}
+	return field, related, nil
+}
+
+func primaryKey(descriptor *ModelDescriptor) (string, error) {
+	key, ok := descriptor.primaryKey()
+	if !ok {
+		return "", fmt.Errorf("%s must have exactly one primary key", descriptor.Name)
+	}
+	return key, nil
+}
+
+// relationKeys returns the distinct values of column in models by relationKey, NULL values are skipped
+func relationKeys(models []Model, column string) []any {
+	var keys []any
+	seen := make(map[string]bool, len(models))
+	for _, model := range models {
+		ptr := model.LormFieldMap()[column]
+		if key, ok := relationKey(ptr); ok && !seen[key] {
+			seen[key] = true
+			keys = append(keys, reflect.Indirect(reflect.ValueOf(ptr).Elem()).Interface())
+		}
+	}
+	return keys
+}
+
+// relationKey formats the value ptr points to, so that keys of different integer types can be matched
+func relationKey(ptr any) (string, bool) {
+	value := reflect.ValueOf(ptr)
+	if !value.IsValid() {
+		return "", false
+	}
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return "", false
+		}
+		value = value.Elem()
+	}
+	return fmt.Sprint(value.Interface()), true
+}
+
+// queryRelated queries the models of the table of prototype matching where
+func queryRelated(ctx context.Context, engine *Engine, prototype Table, where any) ([]Model, error) {
+	escaper := engine.Escaper()
+	fields := lo.Map(prototype.LormModelDescriptor().AllFields(), func(field string, _ int) string {
+		return escaper.Escape(field)
+	})
+	query, args, err := builder.Select(fields...).
+		From(escaper.Escape(prototype.TableName())).
+		Where(where).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var models []Model
+	if err = engine.Query(ctx, newPrototypeScanner(prototype, &models), query, args...); err != nil {
+		return nil, err
+	}
+	return models, nil
+}
+
+// setRelated stores models into a relation field, which is a slice, a pointer or a struct of the related model
+func setRelated(dest reflect.Value, models []Model) {
+	if len(models) == 0 {
+		dest.SetZero()
+		return
+	}
+	if dest.Kind() != reflect.Slice {
+		dest.Set(relatedValue(models[0], dest.Type()))
+		return
+	}
+	slice := reflect.MakeSlice(dest.Type(), 0, len(models))
+	for _, model := range models {
+		slice = reflect.Append(slice, relatedValue(model, dest.Type().Elem()))
+	}
+	dest.Set(slice)
+}
+
+func relatedValue(model Model, typ reflect.Type) reflect.Value {
+	value := reflect.ValueOf(model)
+	if typ.Kind() != reflect.Pointer {
+		value = value.Elem()
+	}
+	return value
+}
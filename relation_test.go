@@ -0,0 +1,52 @@
+package lorm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRelationEngine(t *testing.T) *Engine {
+	engine := newSQLiteEngine(t,
+		"CREATE TABLE rel_user (id INTEGER PRIMARY KEY, name TEXT)",
+		"CREATE TABLE rel_address (id INTEGER PRIMARY KEY, user_id INTEGER, city TEXT)",
+		"CREATE TABLE rel_profile (user_id INTEGER, bio TEXT)",
+		"INSERT INTO rel_user VALUES (1, 'a'), (2, 'b'), (3, 'c')",
+		"INSERT INTO rel_address VALUES (1, 1, 'x'), (2, 2, 'y'), (3, 1, 'z')",
+		"INSERT INTO rel_profile VALUES (2, 'bio')",
+	)
+	return engine
+}
+
+func TestPreload(t *testing.T) {
+	engine := newRelationEngine(t)
+	ctx := context.TODO()
+
+	users, err := Query[*relUser](engine).Preload("Addresses", "Profile").OrderBy("id").Find(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, users, 3) {
+		assert.Equal(t, []string{"x", "z"}, cities(users[0].Addresses))
+		assert.Nil(t, users[0].Profile)
+		assert.Equal(t, []string{"y"}, cities(users[1].Addresses))
+		if assert.NotNil(t, users[1].Profile) {
+			assert.Equal(t, "bio", users[1].Profile.Bio)
+		}
+		assert.Nil(t, users[2].Addresses)
+	}
+
+	address, err := Query[*relAddress](engine).Preload("User").Where("id = ?", 2).Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "b", address.User.Name)
+
+	_, err = Query[*relUser](engine).Preload("Roles").Find(ctx)
+	assert.EqualError(t, err, `lorm: relUser has no relation "Roles"`)
+}
+
+func cities(addresses []*relAddress) []string {
+	var result []string
+	for _, address := range addresses {
+		result = append(result, address.City)
+	}
+	return result
+}
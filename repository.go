@@ -24,6 +24,32 @@ func (r *Repository[T]) GetByField(ctx context.Context, field string, value any)
 		Get(ctx)
 }
 
+// MustGet is like Get but returns ErrNotFound if there is no model of id
+func (r *Repository[T]) MustGet(ctx context.Context, id int64) (T, error) {
+	return r.MustGetByField(ctx, "id", id)
+}
+
+// MustGetByField is like GetByField but returns ErrNotFound if no model matches
+func (r *Repository[T]) MustGetByField(ctx context.Context, field string, value any) (T, error) {
+	return Query[T](r.Engine).
+		Where(builder.Eq{field: value}).
+		Take(ctx)
+}
+
+// FirstByField returns the model with the lowest primary keys matching field, it returns ErrNotFound if no model matches
+func (r *Repository[T]) FirstByField(ctx context.Context, field string, value any) (T, error) {
+	return Query[T](r.Engine).
+		Where(builder.Eq{field: value}).
+		First(ctx)
+}
+
+// LastByField returns the model with the highest primary keys matching field, it returns ErrNotFound if no model matches
+func (r *Repository[T]) LastByField(ctx context.Context, field string, value any) (T, error) {
+	return Query[T](r.Engine).
+		Where(builder.Eq{field: value}).
+		Last(ctx)
+}
+
 func (r *Repository[T]) Lock(ctx context.Context, id int64) (T, error) {
 	return r.LockByField(ctx, "id", id)
 }
@@ -69,6 +95,11 @@ func (r *Repository[T]) Delete(ctx context.Context, id int64) (rowsAffected int6
 	return r.DeleteByField(ctx, "id", id)
 }
 
+// DeleteModel deletes model by its primary keys, unlike Delete it calls the BeforeDelete hook of model
+func (r *Repository[T]) DeleteModel(ctx context.Context, model T) (rowsAffected int64, err error) {
+	return Delete(r.Engine).Model(model).Exec(ctx)
+}
+
 func (r *Repository[T]) DeleteByField(ctx context.Context, field string, value any) (rowsAffected int64, err error) {
 	var table T
 	return Delete(r.Engine).
@@ -7,6 +7,10 @@ import (
 type TestRepository interface {
 	Get(ctx context.Context, id int64) (*Test, error)
 	GetByField(ctx context.Context, field string, value any) (*Test, error)
+	MustGet(ctx context.Context, id int64) (*Test, error)
+	MustGetByField(ctx context.Context, field string, value any) (*Test, error)
+	FirstByField(ctx context.Context, field string, value any) (*Test, error)
+	LastByField(ctx context.Context, field string, value any) (*Test, error)
 	Lock(ctx context.Context, id int64) (*Test, error)
 	LockByField(ctx context.Context, field string, value any) (*Test, error)
 	Exist(ctx context.Context, id int64) (bool, error)
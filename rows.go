@@ -0,0 +1,130 @@
+package lorm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// Rows is a cursor over the result of a query, it decodes one row at a time so that large results are not loaded into memory,
+// it keeps the connection busy until it is closed
+//
+//	rows, err := Query[*User](engine).Iter(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer rows.Close()
+//	for rows.Next() {
+//		user, err := rows.Scan()
+//		...
+//	}
+//	return rows.Err()
+type Rows[T any] struct {
+	ctx     context.Context
+	rows    *sql.Rows
+	closer  func() error
+	columns []string
+	decode  func(ctx context.Context, rows *sql.Rows, columns []string) (T, error)
+	closed  bool
+}
+
+func newRows[T any](ctx context.Context, engine *Engine, query string, args []any,
+	decode func(ctx context.Context, rows *sql.Rows, columns []string) (T, error)) (*Rows[T], error) {
+	rows, closer, err := engine.rows(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := rows.Columns()
+	if err != nil {
+		_ = closer()
+		return nil, err
+	}
+	return &Rows[T]{ctx: withEngine(ctx, engine), rows: rows, closer: closer, columns: columns, decode: decode}, nil
+}
+
+// Next prepares the next row for Scan, it returns false when there are no more rows or an error occurred, see Err
+func (r *Rows[T]) Next() bool {
+	return r.rows.Next()
+}
+
+// Scan decodes the current row
+func (r *Rows[T]) Scan() (T, error) {
+	return r.decode(r.ctx, r.rows, r.columns)
+}
+
+// Err returns the error encountered during the iteration
+func (r *Rows[T]) Err() error {
+	return r.rows.Err()
+}
+
+// Close closes the rows and releases the connection, it can be called more than once
+func (r *Rows[T]) Close() error {
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	return r.closer()
+}
+
+// each calls fn for every row until fn returns an error, the rows are closed when it returns
+func (r *Rows[T]) each(fn func(T) error) (err error) {
+	defer func() {
+		if closeErr := r.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	for r.Next() {
+		v, err := r.Scan()
+		if err != nil {
+			return err
+		}
+		if err = fn(v); err != nil {
+			return err
+		}
+	}
+	return r.Err()
+}
+
+// decodeModel returns the decoder of models created by prototype, the columns are checked against strict once per result set,
+// the result of the check is returned for every row
+func decodeModel[T Model](prototype T, strict strictColumns) func(ctx context.Context, rows *sql.Rows, columns []string) (T, error) {
+	descriptor := prototype.LormModelDescriptor()
+	var (
+		checked  bool
+		checkErr error
+		decoder  *modelDecoder
+	)
+	return func(ctx context.Context, rows *sql.Rows, columns []string) (T, error) {
+		var zero T
+		engine := engineFromContext(ctx)
+		if !checked {
+			checked = true
+			checkErr = strict.check(engine, descriptor, columns)
+		}
+		if checkErr != nil {
+			return zero, checkErr
+		}
+		if decoder == nil {
+			decoder = newModelDecoder(engine, prototype, columns)
+		}
+		item := prototype.New()
+		if err := rows.Scan(decoder.dests(item)...); err != nil {
+			return zero, err
+		}
+		model := item.(T)
+		if err := callHooks(ctx, []T{model}, AfterFindHook.AfterFind); err != nil {
+			return zero, err
+		}
+		return model, nil
+	}
+}
+
+// decodeCol decodes the single column of a row
+func decodeCol[T any](ctx context.Context, rows *sql.Rows, columns []string) (T, error) {
+	var item T
+	if len(columns) != 1 {
+		return item, fmt.Errorf("expected exactly one column, got %d", len(columns))
+	}
+	err := rows.Scan(engineFromContext(ctx).convert(&item))
+	return item, err
+}
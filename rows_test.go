@@ -0,0 +1,89 @@
+package lorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRowsEngine(t *testing.T) *Engine {
+	engine := newSQLiteEngine(t, "CREATE TABLE null_model (id INTEGER PRIMARY KEY, nickname TEXT, score INTEGER, extra TEXT, phone TEXT, age INTEGER)")
+	models := make([]*nullModel, 0, 10)
+	for i := 1; i <= 10; i++ {
+		models = append(models, &nullModel{ID: int64(i), Extra: &Sub{ID: i, Name: fmt.Sprint("n", i)}})
+	}
+	if _, err := InsertAll(context.TODO(), engine, models); err != nil {
+		t.Fatal(err)
+	}
+	return engine
+}
+
+func TestQueryModelIter(t *testing.T) {
+	engine := newRowsEngine(t)
+	ctx := context.TODO()
+
+	rows, err := Query[*nullModel](engine).Where("id > ?", 7).OrderBy("id").Iter(ctx)
+	assert.NoError(t, err)
+	var names []string
+	for rows.Next() {
+		m, err := rows.Scan()
+		assert.NoError(t, err)
+		names = append(names, m.Extra.Name)
+	}
+	assert.NoError(t, rows.Err())
+	assert.NoError(t, rows.Close())
+	assert.NoError(t, rows.Close())
+	assert.Equal(t, []string{"n8", "n9", "n10"}, names)
+
+	// Each stops at the first error and releases the single connection
+	stop := errors.New("stop")
+	var ids []int64
+	err = Query[*nullModel](engine).OrderBy("id").Each(ctx, func(m *nullModel) error {
+		ids = append(ids, m.ID)
+		if len(ids) == 3 {
+			return stop
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, []int64{1, 2, 3}, ids)
+
+	count, _, err := QueryCol[int](engine).From("null_model").Columns("COUNT(*)").Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, count)
+}
+
+func TestQueryColIter(t *testing.T) {
+	engine := newRowsEngine(t)
+	ctx := context.TODO()
+
+	var sum int64
+	err := QueryCol[int64](engine).From("null_model").Columns("id").Each(ctx, func(id int64) error {
+		sum += id
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 55, sum)
+
+	err = QueryCol[int64](engine).From("null_model").Columns("id", "age").Each(ctx, func(int64) error {
+		return nil
+	})
+	assert.EqualError(t, err, "expected exactly one column, got 2")
+
+	// the cursor uses the connection of the transaction
+	err = engine.TX(ctx, func(ctx context.Context) error {
+		rows, err := QueryCol[int64](engine).From("null_model").Columns("id").Iter(ctx)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		assert.True(t, rows.Next())
+		id, err := rows.Scan()
+		assert.EqualValues(t, 1, id)
+		return err
+	})
+	assert.NoError(t, err)
+}
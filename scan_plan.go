@@ -0,0 +1,112 @@
+package lorm
+
+import (
+	"database/sql"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// scanPlans caches the scan plans by scanPlanKey
+var scanPlans sync.Map
+
+type scanPlanKey struct {
+	typ     reflect.Type
+	columns string
+}
+
+// scanPlan maps the result columns of a query to the fields of a model type
+type scanPlan struct {
+	// fields are the fields of the columns, it is nil for columns without a field
+	fields []*FieldDescriptor
+	// indexes are the indexes of fields in ModelDescriptor.Fields, it is -1 for columns without a field
+	indexes []int
+}
+
+// scanPlanOf returns the cached scan plan of the type of model and columns
+func scanPlanOf(model Model, columns []string) *scanPlan {
+	key := scanPlanKey{typ: reflect.TypeOf(model), columns: strings.Join(columns, "\x00")}
+	if plan, ok := scanPlans.Load(key); ok {
+		return plan.(*scanPlan)
+	}
+	descriptor := model.LormModelDescriptor()
+	indexes := make(map[string]int, len(descriptor.Fields))
+	for i, field := range descriptor.Fields {
+		indexes[field.DBField] = i
+	}
+	plan := &scanPlan{fields: make([]*FieldDescriptor, len(columns)), indexes: make([]int, len(columns))}
+	for i, column := range columns {
+		index, ok := indexes[column]
+		if !ok {
+			plan.indexes[i] = -1
+			continue
+		}
+		plan.fields[i], plan.indexes[i] = descriptor.Fields[index], index
+	}
+	actual, _ := scanPlans.LoadOrStore(key, plan)
+	return actual.(*scanPlan)
+}
+
+// modelDecoder scans the rows of a query into models by a scan plan, the destinations are reused across rows
+// and only the fields which need a wrapper, see Engine.wrapField, are wrapped
+type modelDecoder struct {
+	engine *Engine
+	plan   *scanPlan
+	// wrap reports whether the field of every column needs a wrapper, it is computed on the first row
+	wrap    []bool
+	dest    []any
+	discard sql.RawBytes
+}
+
+func newModelDecoder(engine *Engine, prototype Model, columns []string) *modelDecoder {
+	return &modelDecoder{engine: engine, plan: scanPlanOf(prototype, columns), dest: make([]any, 0, len(columns))}
+}
+
+// dests returns the destinations of the columns of the current row into model, they are valid until the next call
+func (d *modelDecoder) dests(model Model) []any {
+	dest := d.dest[:0]
+	if m, ok := model.(ScanDestModel); ok {
+		dest = m.LormScanDest(d.plan.indexes, dest)
+	} else {
+		fieldMap := model.LormFieldMap()
+		for _, field := range d.plan.fields {
+			if field == nil {
+				dest = append(dest, nil)
+				continue
+			}
+			dest = append(dest, fieldMap[field.DBField])
+		}
+	}
+	if d.wrap == nil {
+		d.wrap = make([]bool, len(dest))
+		for i, field := range d.plan.fields {
+			d.wrap[i] = field != nil && d.engine.needsWrap(field, dest[i])
+		}
+	}
+	for i, field := range d.plan.fields {
+		switch {
+		case field == nil:
+			dest[i] = &d.discard
+		case d.wrap[i]:
+			dest[i] = d.engine.wrapField(field, dest[i])
+		}
+	}
+	d.dest = dest
+	return dest
+}
+
+// needsWrap reports whether wrapField returns a wrapper of ptr instead of ptr itself
+func (e *Engine) needsWrap(field *FieldDescriptor, ptr any) bool {
+	if _, ok := fieldCodec(field.Flag); ok {
+		return true
+	}
+	if field.Flag.HasFlag(FlagEncrypted | FlagNullZero) {
+		return true
+	}
+	typ := reflect.TypeOf(ptr)
+	if typ == nil || typ.Kind() != reflect.Pointer {
+		return false
+	}
+	_, ok := e.converter(typ.Elem())
+	return ok
+}
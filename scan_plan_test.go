@@ -0,0 +1,93 @@
+package lorm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var planModelDescriptor = new(planModel).LormModelDescriptor()
+
+// planMapModel is scanned through LormFieldMap, its LormScanDest hides the one of planModel
+// so that it does not implement ScanDestModel
+type planMapModel struct {
+	planModel
+}
+
+func (m *planMapModel) TableName() string { return "plan_model" }
+func (m *planMapModel) New() Model        { return new(planMapModel) }
+func (m *planMapModel) LormModelDescriptor() *ModelDescriptor {
+	return planModelDescriptor
+}
+func (m *planMapModel) LormScanDest() {}
+
+func newPlanEngine(t testing.TB, n int) *Engine {
+	engine := newSQLiteEngine(t, "CREATE TABLE plan_model (id INTEGER PRIMARY KEY, name TEXT, score REAL, tags TEXT)")
+	models := make([]*planModel, 0, n)
+	for i := 1; i <= n; i++ {
+		models = append(models, &planModel{ID: int64(i), Name: fmt.Sprint("n", i), Score: float64(i) / 2, Tags: []string{"a", fmt.Sprint(i)}})
+	}
+	if _, err := InsertAll(context.TODO(), engine, models); err != nil {
+		t.Fatal(err)
+	}
+	return engine
+}
+
+func TestScanPlan(t *testing.T) {
+	plan := scanPlanOf(new(planModel), []string{"score", "x", "id"})
+	assert.Same(t, plan, scanPlanOf(new(planModel), []string{"score", "x", "id"}))
+	assert.NotSame(t, plan, scanPlanOf(new(planMapModel), []string{"score", "x", "id"}))
+	assert.Equal(t, []int{2, -1, 0}, plan.indexes)
+	assert.Equal(t, []*FieldDescriptor{planModelDescriptor.Fields[2], nil, planModelDescriptor.Fields[0]}, plan.fields)
+
+	engine := newPlanEngine(t, 3)
+	ctx := context.TODO()
+	expected := &planModel{ID: 2, Name: "n2", Score: 1, Tags: []string{"a", "2"}}
+
+	list, err := Query[*planModel](engine).AddColumn("1 AS x").OrderBy("id").Find(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, list, 3) {
+		assert.Equal(t, expected, list[1])
+	}
+	mapList, err := Query[*planMapModel](engine).OrderBy("id").Find(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, mapList, 3) {
+		assert.Equal(t, expected, &mapList[1].planModel)
+	}
+	m, err := Query[*planModel](engine).Where("id = ?", 2).MustGet(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, m)
+
+	var names []string
+	err = Query[*planModel](engine).OrderBy("id").Each(ctx, func(m *planModel) error {
+		names = append(names, m.Name)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"n1", "n2", "n3"}, names)
+}
+
+func BenchmarkModelsScanner(b *testing.B) {
+	engine := newPlanEngine(b, 1000)
+	ctx := context.TODO()
+	b.Run("scan_dest", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var models []*planModel
+			if err := engine.Query(ctx, NewModelsScanner(&models), "SELECT id, name, score FROM plan_model"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("field_map", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var models []*planMapModel
+			if err := engine.Query(ctx, NewModelsScanner(&models), "SELECT id, name, score FROM plan_model"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -1,51 +1,68 @@
 package lorm
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
+
+	"github.com/samber/lo"
 )
 
 type Scanner interface {
 	Scan(*sql.Rows) error
 }
 
+// ContextScanner is a Scanner which needs the context of the query, eg: to call model hooks.
+// Engine.Query prefers ScanContext over Scan when a scanner implements it
+type ContextScanner interface {
+	Scanner
+	ScanContext(context.Context, *sql.Rows) error
+}
+
 type ModelsScanner[T Model] struct {
 	models *[]T
+	// prototype creates the scanned models, it is the zero T unless T is an interface
+	prototype T
+	strict    strictColumns
 }
 
 func NewModelsScanner[T Model](models *[]T) *ModelsScanner[T] {
 	return &ModelsScanner[T]{models: models}
 }
+
+// newPrototypeScanner returns a scanner of models created by prototype.New, it is used when the model type is only known at runtime
+func newPrototypeScanner(prototype Model, models *[]Model) *ModelsScanner[Model] {
+	return &ModelsScanner[Model]{models: models, prototype: prototype}
+}
+
+// Strict enables strict column mode, the scan fails if the result has columns without a field
+// or misses fields of the model other than exclude
+func (m *ModelsScanner[T]) Strict(exclude ...string) *ModelsScanner[T] {
+	m.strict = strictColumns{enabled: true, exclude: exclude}
+	return m
+}
+
 func (m *ModelsScanner[T]) Scan(rows *sql.Rows) error {
+	return m.ScanContext(context.Background(), rows)
+}
+
+func (m *ModelsScanner[T]) ScanContext(ctx context.Context, rows *sql.Rows) error {
 	columns, err := rows.Columns()
 	if err != nil {
 		return err
 	}
 	var models []T
-	var model T
-	jsonFields := make(map[string]struct{})
-	for _, field := range model.LormModelDescriptor().Fields {
-		if field.Flag.HasFlag(FlagJson) {
-			jsonFields[field.DBField] = struct{}{}
-		}
+	model := m.prototype
+	engine := engineFromContext(ctx)
+	descriptor := model.LormModelDescriptor()
+	if err = m.strict.check(engine, descriptor, columns); err != nil {
+		return err
 	}
+	decoder := newModelDecoder(engine, model, columns)
 	for rows.Next() {
 		item := model.New()
-		fieldsMap := item.LormFieldMap()
-		values := make([]any, len(columns))
-		for i, column := range columns {
-			field, ok := fieldsMap[column]
-			if !ok {
-				values[i] = new(sql.RawBytes)
-				continue
-			}
-			if _, ok = jsonFields[column]; ok {
-				field = NewJSONFieldWrapper(field)
-			}
-			values[i] = field
-		}
-		if err = rows.Scan(values...); err != nil {
+		if err = rows.Scan(decoder.dests(item)...); err != nil {
 			return err
 		}
 		models = append(models, item.(T))
@@ -54,6 +71,9 @@ func (m *ModelsScanner[T]) Scan(rows *sql.Rows) error {
 	if err = rows.Err(); err != nil {
 		return err
 	}
+	if err = callHooks(ctx, models, AfterFindHook.AfterFind); err != nil {
+		return err
+	}
 	*m.models = models
 	return nil
 }
@@ -67,6 +87,11 @@ func NewColsScanner[T any](v *[]T) *ColsScanner[T] {
 }
 
 func (m *ColsScanner[T]) Scan(rows *sql.Rows) error {
+	return m.ScanContext(context.Background(), rows)
+}
+
+func (m *ColsScanner[T]) ScanContext(ctx context.Context, rows *sql.Rows) error {
+	engine := engineFromContext(ctx)
 	columns, err := rows.Columns()
 	if err != nil {
 		return err
@@ -77,7 +102,7 @@ func (m *ColsScanner[T]) Scan(rows *sql.Rows) error {
 	var v []T
 	for rows.Next() {
 		var item T
-		if err = rows.Scan(&item); err != nil {
+		if err = rows.Scan(engine.convert(&item)); err != nil {
 			return err
 		}
 		v = append(v, item)
@@ -87,38 +112,39 @@ func (m *ColsScanner[T]) Scan(rows *sql.Rows) error {
 }
 
 type ModelScanner[T Model] struct {
-	model T
+	model  T
+	strict strictColumns
 }
 
 func NewModelScanner[T Model](model T) *ModelScanner[T] {
 	return &ModelScanner[T]{model: model}
 }
 
+// Strict enables strict column mode, see ModelsScanner.Strict
+func (m *ModelScanner[T]) Strict(exclude ...string) *ModelScanner[T] {
+	m.strict = strictColumns{enabled: true, exclude: exclude}
+	return m
+}
+
 func (m *ModelScanner[T]) Scan(row *sql.Rows) error {
+	return m.ScanContext(context.Background(), row)
+}
+
+func (m *ModelScanner[T]) ScanContext(ctx context.Context, row *sql.Rows) error {
 	columns, err := row.Columns()
 	if err != nil {
 		return err
 	}
-	jsonFields := make(map[string]struct{})
-	for _, field := range m.model.LormModelDescriptor().Fields {
-		if field.Flag.HasFlag(FlagJson) {
-			jsonFields[field.DBField] = struct{}{}
-		}
+	engine := engineFromContext(ctx)
+	descriptor := m.model.LormModelDescriptor()
+	if err = m.strict.check(engine, descriptor, columns); err != nil {
+		return err
 	}
-	fieldsMap := m.model.LormFieldMap()
-	values := make([]any, len(columns))
-	for i, column := range columns {
-		field, ok := fieldsMap[column]
-		if !ok {
-			values[i] = new(sql.RawBytes)
-			continue
-		}
-		if _, ok = jsonFields[column]; ok {
-			field = NewJSONFieldWrapper(field)
-		}
-		values[i] = field
+	values := newModelDecoder(engine, m.model, columns).dests(m.model)
+	if err = scanRow(row, values...); err != nil {
+		return err
 	}
-	return scanRow(row, values...)
+	return callHooks(ctx, []T{m.model}, AfterFindHook.AfterFind)
 }
 
 type ColScanner[T any] struct {
@@ -130,6 +156,10 @@ func NewColScanner[T any](v T) *ColScanner[T] {
 }
 
 func (m *ColScanner[T]) Scan(row *sql.Rows) error {
+	return m.ScanContext(context.Background(), row)
+}
+
+func (m *ColScanner[T]) ScanContext(ctx context.Context, row *sql.Rows) error {
 	columns, err := row.Columns()
 	if err != nil {
 		return err
@@ -137,7 +167,52 @@ func (m *ColScanner[T]) Scan(row *sql.Rows) error {
 	if len(columns) != 1 {
 		return fmt.Errorf("expected exactly one column, got %d", len(columns))
 	}
-	return scanRow(row, m.v)
+	return scanRow(row, engineFromContext(ctx).convert(m.v))
+}
+
+// MultiScanner scans the result sets of a query in order, one scanner per result set, eg: a header row and its detail rows
+// returned by a stored procedure, the scan fails if the number of result sets differs from the number of scanners
+type MultiScanner struct {
+	scanners []Scanner
+}
+
+func NewMultiScanner(scanners ...Scanner) *MultiScanner {
+	return &MultiScanner{scanners: scanners}
+}
+
+func (m *MultiScanner) Scan(rows *sql.Rows) error {
+	return m.ScanContext(context.Background(), rows)
+}
+
+func (m *MultiScanner) ScanContext(ctx context.Context, rows *sql.Rows) error {
+	for i, scanner := range m.scanners {
+		if i > 0 && !rows.NextResultSet() {
+			if err := rows.Err(); err != nil {
+				return err
+			}
+			return fmt.Errorf("expected %d result sets, got %d", len(m.scanners), i)
+		}
+		var err error
+		if contextScanner, ok := scanner.(ContextScanner); ok {
+			err = contextScanner.ScanContext(ctx, rows)
+		} else {
+			err = scanner.Scan(rows)
+		}
+		if err != nil {
+			return fmt.Errorf("result set %d: %w", i+1, err)
+		}
+	}
+	if rows.NextResultSet() {
+		return fmt.Errorf("expected %d result sets, got more", len(m.scanners))
+	}
+	return rows.Err()
+}
+
+// descriptorFieldMap returns the fields of descriptor by db field name
+func descriptorFieldMap(descriptor *ModelDescriptor) map[string]*FieldDescriptor {
+	return lo.KeyBy(descriptor.Fields, func(item *FieldDescriptor) string {
+		return item.DBField
+	})
 }
 
 func scanRow(rows *sql.Rows, dest ...interface{}) error {
@@ -2,8 +2,12 @@ package lorm
 
 import (
 	"context"
-	"github.com/stretchr/testify/assert"
+	"database/sql"
+	"database/sql/driver"
+	"io"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestScanRowErrorBranches(t *testing.T) {
@@ -42,3 +46,92 @@ func TestColScannerNoRows(t *testing.T) {
 	err = e.Query(ctx, NewColScanner(&x), "SELECT 1 WHERE 1=2")
 	assert.Error(t, err)
 }
+
+// multiDriver returns the result sets of resultSets for every query, the sqlite driver only returns the first result set
+type multiDriver struct{}
+
+type multiResultSet struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+var multiResultSets = []multiResultSet{
+	{columns: []string{"id", "age"}, rows: [][]driver.Value{{int64(1), int64(30)}, {int64(9), int64(40)}}},
+	{columns: []string{"score"}, rows: [][]driver.Value{{int64(3)}, {int64(5)}, {int64(8)}}},
+}
+
+func (multiDriver) Open(string) (driver.Conn, error) { return multiConn{}, nil }
+
+type multiConn struct{}
+
+func (multiConn) Prepare(string) (driver.Stmt, error) { return multiStmt{}, nil }
+func (multiConn) Close() error                        { return nil }
+func (multiConn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+
+type multiStmt struct{}
+
+func (multiStmt) Close() error                               { return nil }
+func (multiStmt) NumInput() int                              { return -1 }
+func (multiStmt) Exec([]driver.Value) (driver.Result, error) { return driver.RowsAffected(0), nil }
+func (multiStmt) Query([]driver.Value) (driver.Rows, error)  { return &multiRows{}, nil }
+
+type multiRows struct {
+	set, row int
+}
+
+func (r *multiRows) Columns() []string      { return multiResultSets[r.set].columns }
+func (r *multiRows) Close() error           { return nil }
+func (r *multiRows) HasNextResultSet() bool { return r.set+1 < len(multiResultSets) }
+
+func (r *multiRows) NextResultSet() error {
+	if !r.HasNextResultSet() {
+		return io.EOF
+	}
+	r.set, r.row = r.set+1, 0
+	return nil
+}
+
+func (r *multiRows) Next(dest []driver.Value) error {
+	rows := multiResultSets[r.set].rows
+	if r.row >= len(rows) {
+		return io.EOF
+	}
+	copy(dest, rows[r.row])
+	r.row++
+	return nil
+}
+
+func init() {
+	sql.Register("lorm_multi", multiDriver{})
+}
+
+func TestMultiScanner(t *testing.T) {
+	engine, err := NewEngine("lorm_multi", "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer engine.Close()
+	ctx := context.TODO()
+
+	header := new(nullModel)
+	var scores []int
+	err = engine.Query(ctx, NewMultiScanner(NewModelScanner(header), NewColsScanner(&scores)), "CALL order_detail(?)", 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, header.ID)
+	assert.Equal(t, 30, header.Age)
+	assert.Equal(t, []int{3, 5, 8}, scores)
+
+	var models []*nullModel
+	err = engine.Query(ctx, NewMultiScanner(NewModelsScanner(&models)), "CALL order_detail(?)", 1)
+	assert.EqualError(t, err, "expected 1 result sets, got more")
+	assert.Len(t, models, 2)
+
+	err = engine.Query(ctx, NewMultiScanner(NewModelsScanner(&models), NewColsScanner(&scores), NewColsScanner(&scores)), "CALL order_detail()")
+	assert.EqualError(t, err, "expected 3 result sets, got 2")
+
+	// errors of a scanner name its result set
+	err = engine.Query(ctx, NewMultiScanner(NewModelsScanner(&models), NewModelsScanner(&models).Strict()), "CALL order_detail()")
+	var mismatch *ColumnMismatchError
+	assert.ErrorAs(t, err, &mismatch)
+	assert.ErrorContains(t, err, "result set 2: ")
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"slices"
 
 	"github.com/samber/lo"
 	"github.com/yvvlee/lorm/builder"
 )
 
+// ErrNotFound is returned by MustGet, First, Last and Take when the query has no rows, it wraps sql.ErrNoRows
+var ErrNotFound = fmt.Errorf("lorm: record not found: %w", sql.ErrNoRows)
+
 func Query[T Model](engine *Engine) *QueryModelStmt[T] {
 	var t T
 	fields := t.LormModelDescriptor().AllFields()
@@ -30,25 +35,96 @@ func Query[T Model](engine *Engine) *QueryModelStmt[T] {
 type QueryModelStmt[T Model] struct {
 	engine  *Engine
 	builder *builder.SelectBuilder
+	// preloads are the relations loaded after Get and Find
+	preloads []string
+	strict   strictColumns
+}
+
+// clone returns a copy of the statement, changing the copy does not change the statement
+func (s *QueryModelStmt[T]) clone() *QueryModelStmt[T] {
+	clone := *s
+	clone.builder = s.builder.Clone()
+	clone.preloads = slices.Clone(s.preloads)
+	return &clone
+}
+
+// Preload loads the relations declared on the fields names after Get and Find, eg: Query[*User](engine).Preload("Addresses"),
+// every relation is loaded by one batched IN query
+func (s *QueryModelStmt[T]) Preload(names ...string) *QueryModelStmt[T] {
+	s.preloads = append(s.preloads, names...)
+	return s
+}
+
+// Strict enables strict column mode for the query, it fails with a *ColumnMismatchError if the result has columns
+// without a field or misses fields of the model other than exclude, eg: Query[*User](engine).Select("id", "name").Strict("email")
+func (s *QueryModelStmt[T]) Strict(exclude ...string) *QueryModelStmt[T] {
+	s.strict = strictColumns{enabled: true, exclude: exclude}
+	return s
 }
 
+// Get returns the first model of the query, it returns the zero T and a nil error if there are no rows, see MustGet
 func (s *QueryModelStmt[T]) Get(ctx context.Context) (T, error) {
+	t, err := s.MustGet(ctx)
+	if errors.Is(err, ErrNotFound) {
+		return t, nil
+	}
+	return t, err
+}
+
+// MustGet is like Get but returns ErrNotFound if there are no rows
+func (s *QueryModelStmt[T]) MustGet(ctx context.Context) (T, error) {
 	var t T
 	query, args, err := s.builder.ToSql()
 	if err != nil {
 		return t, err
 	}
 	res := t.New()
-	err = s.engine.Query(ctx, NewModelScanner(res), query, args...)
+	err = s.engine.Query(ctx, &ModelScanner[Model]{model: res, strict: s.strict}, query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return t, nil
+			return t, ErrNotFound
 		}
 		return t, err
 	}
+	if err = preload(ctx, s.engine, []T{res.(T)}, s.preloads); err != nil {
+		return t, err
+	}
 	return res.(T), nil
 }
 
+// First returns the first model of the query ordered by the primary keys, the order is appended to the ORDER BY clause
+// of a copy of the statement, it returns ErrNotFound if there are no rows
+func (s *QueryModelStmt[T]) First(ctx context.Context) (T, error) {
+	return s.orderedGet(ctx, "ASC")
+}
+
+// Last is like First but orders by the primary keys descending
+func (s *QueryModelStmt[T]) Last(ctx context.Context) (T, error) {
+	return s.orderedGet(ctx, "DESC")
+}
+
+// Take returns a model of the query without adding an order, it returns ErrNotFound if there are no rows
+func (s *QueryModelStmt[T]) Take(ctx context.Context) (T, error) {
+	stmt := s.clone()
+	stmt.builder.Limit(1)
+	return stmt.MustGet(ctx)
+}
+
+func (s *QueryModelStmt[T]) orderedGet(ctx context.Context, direction string) (T, error) {
+	var t T
+	descriptor := t.LormModelDescriptor()
+	primaryKeys := descriptor.FlagFields(FlagPrimaryKey)
+	if len(primaryKeys) == 0 {
+		return t, fmt.Errorf("lorm: %s has no primary key", descriptor.Name)
+	}
+	escaper := s.engine.Escaper()
+	stmt := s.clone()
+	stmt.builder.OrderBy(lo.Map(primaryKeys, func(key string, _ int) string {
+		return escaper.Escape(key) + " " + direction
+	})...).Limit(1)
+	return stmt.MustGet(ctx)
+}
+
 func (s *QueryModelStmt[T]) Exist(ctx context.Context) (bool, error) {
 	query, args, err := s.builder.ToSql()
 	if err != nil {
@@ -63,42 +139,48 @@ func (s *QueryModelStmt[T]) Find(ctx context.Context) ([]T, error) {
 		return nil, err
 	}
 	var t []T
-	err = s.engine.Query(ctx, NewModelsScanner(&t), query, args...)
+	err = s.engine.Query(ctx, &ModelsScanner[T]{models: &t, strict: s.strict}, query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
+	if err = preload(ctx, s.engine, t, s.preloads); err != nil {
+		return nil, err
+	}
 	return t, nil
 }
 
-func (s *QueryModelStmt[T]) Page(ctx context.Context, page, size uint64) ([]T, uint64, error) {
-	if size == 0 {
-		return nil, 0, errors.New("size can not be zero")
-	}
-	if page == 0 {
-		page = 1
-	}
-	offset := (page - 1) * size
-	s.builder.Limit(size).Offset(offset)
-	countStmt := QueryCol[uint64](s.engine)
-	countStmt.builder = s.builder.ToCountBuilder()
-	count, ok, err := countStmt.Get(ctx)
+// Iter runs the query and returns a cursor decoding one model at a time, the cursor must be closed,
+// relations set by Preload are not loaded
+func (s *QueryModelStmt[T]) Iter(ctx context.Context) (*Rows[T], error) {
+	query, args, err := s.builder.ToSql()
 	if err != nil {
-		return nil, 0, err
-	}
-	if !ok || count == 0 {
-		return nil, 0, nil
+		return nil, err
 	}
-	if offset >= count {
-		return nil, count, nil
+	var t T
+	return newRows(ctx, s.engine, query, args, decodeModel(t, s.strict))
+}
+
+// Each calls fn for every model of the query without loading them all into memory,
+// it stops at the first error returned by fn and returns it
+func (s *QueryModelStmt[T]) Each(ctx context.Context, fn func(T) error) error {
+	rows, err := s.Iter(ctx)
+	if err != nil {
+		return err
 	}
-	list, err := s.Find(ctx)
+	return rows.each(fn)
+}
+
+// Page returns the models of page, which starts at 1, and the number of models matched by the query,
+// the query itself is left unchanged, see Paginate for the options of the count
+func (s *QueryModelStmt[T]) Page(ctx context.Context, page, size uint64) ([]T, uint64, error) {
+	result, err := s.Paginate(ctx, page, size)
 	if err != nil {
-		return nil, count, err
+		return nil, 0, err
 	}
-	return list, count, nil
+	return result.Items, result.Total, nil
 }
 
 // Prefix adds an expression to the beginning of the query
@@ -306,19 +388,31 @@ type QueryColStmt[T any] struct {
 }
 
 func (s *QueryColStmt[T]) Get(ctx context.Context) (T, bool, error) {
+	t, err := s.MustGet(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return t, false, nil
+		}
+		return t, false, err
+	}
+	return t, true, nil
+}
+
+// MustGet is like Get but returns ErrNotFound if there are no rows
+func (s *QueryColStmt[T]) MustGet(ctx context.Context) (T, error) {
 	var t T
 	query, args, err := s.builder.ToSql()
 	if err != nil {
-		return t, false, err
+		return t, err
 	}
 	err = s.engine.Query(ctx, NewColScanner(&t), query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return t, false, nil
+			return t, ErrNotFound
 		}
-		return t, false, err
+		return t, err
 	}
-	return t, true, nil
+	return t, nil
 }
 
 func (s *QueryColStmt[T]) Find(ctx context.Context) ([]T, error) {
@@ -337,6 +431,25 @@ func (s *QueryColStmt[T]) Find(ctx context.Context) ([]T, error) {
 	return t, nil
 }
 
+// Iter runs the query and returns a cursor decoding one value at a time, the cursor must be closed
+func (s *QueryColStmt[T]) Iter(ctx context.Context) (*Rows[T], error) {
+	query, args, err := s.builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return newRows(ctx, s.engine, query, args, decodeCol[T])
+}
+
+// Each calls fn for every value of the query without loading them all into memory,
+// it stops at the first error returned by fn and returns it
+func (s *QueryColStmt[T]) Each(ctx context.Context, fn func(T) error) error {
+	rows, err := s.Iter(ctx)
+	if err != nil {
+		return err
+	}
+	return rows.each(fn)
+}
+
 // Prefix adds an expression to the beginning of the query
 func (s *QueryColStmt[T]) Prefix(sql string, args ...any) *QueryColStmt[T] {
 	s.builder.Prefix(sql, args...)
@@ -3,6 +3,7 @@ package lorm
 import (
 	"context"
 	"database/sql"
+	"errors"
 )
 
 type session struct {
@@ -52,10 +53,42 @@ func (s *session) Query(ctx context.Context, scanner Scanner, query string, args
 		return
 	}
 	defer rows.Close()
+	if contextScanner, ok := scanner.(ContextScanner); ok {
+		err = contextScanner.ScanContext(withEngine(ctx, s.engine), rows)
+		return
+	}
 	err = scanner.Scan(rows)
 	return
 }
 
+// Rows runs query and leaves the rows open, the returned function closes the rows and the prepared statement
+func (s *session) Rows(ctx context.Context, query string, args ...any) (rows *sql.Rows, closer func() error, err error) {
+	proxy := s.proxy()
+	if len(args) == 0 {
+		rows, err = proxy.QueryContext(ctx, query)
+		if err != nil {
+			return nil, nil, err
+		}
+		return rows, rows.Close, nil
+	}
+	query, err = s.engine.Placeholder().ReplacePlaceholders(query)
+	if err != nil {
+		return nil, nil, err
+	}
+	stmt, err := proxy.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, err = stmt.QueryContext(ctx, args...)
+	if err != nil {
+		_ = stmt.Close()
+		return nil, nil, err
+	}
+	return rows, func() error {
+		return errors.Join(rows.Close(), stmt.Close())
+	}, nil
+}
+
 func (s *session) Exist(ctx context.Context, query string, args ...any) (exist bool, err error) {
 	proxy := s.proxy()
 	var rows *sql.Rows
@@ -0,0 +1,29 @@
+package lorm
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+)
+
+// newSQLiteEngine opens an in-memory sqlite engine and runs the given DDL statements,
+// a single connection is used because every connection gets its own in-memory database
+func newSQLiteEngine(t testing.TB, ddl ...string) *Engine {
+	t.Helper()
+	engine, err := NewEngine("sqlite3", ":memory:",
+		WithMaxOpenConns(1),
+		WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil))),
+	)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = engine.Close()
+	})
+	for _, query := range ddl {
+		if _, err = engine.db.Exec(query); err != nil {
+			t.Fatalf("exec %q: %v", query, err)
+		}
+	}
+	return engine
+}
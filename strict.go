@@ -0,0 +1,52 @@
+package lorm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// ColumnMismatchError is returned by model scanners in strict mode when the result columns do not match the fields of the model
+type ColumnMismatchError struct {
+	Model string
+	// Extra are the result columns without a field
+	Extra []string
+	// Missing are the fields absent from the result
+	Missing []string
+}
+
+func (e *ColumnMismatchError) Error() string {
+	var messages []string
+	if len(e.Extra) > 0 {
+		messages = append(messages, "extra columns "+strings.Join(e.Extra, ", "))
+	}
+	if len(e.Missing) > 0 {
+		messages = append(messages, "missing columns "+strings.Join(e.Missing, ", "))
+	}
+	return fmt.Sprintf("lorm: columns of %s do not match: %s", e.Model, strings.Join(messages, "; "))
+}
+
+// strictColumns is the strict column mode of a query, it is enabled by the query or by WithStrictColumns
+type strictColumns struct {
+	enabled bool
+	// exclude are the fields which may be absent from the result
+	exclude []string
+}
+
+// check returns a *ColumnMismatchError if strict mode is enabled and columns do not match the fields of descriptor
+func (s strictColumns) check(engine *Engine, descriptor *ModelDescriptor, columns []string) error {
+	if !s.enabled && (engine == nil || !engine.config.strictColumns) {
+		return nil
+	}
+	fields := descriptorFieldMap(descriptor)
+	extra := lo.Filter(columns, func(column string, _ int) bool {
+		_, ok := fields[column]
+		return !ok
+	})
+	missing := lo.Without(lo.Without(descriptor.AllFields(), columns...), s.exclude...)
+	if len(extra) == 0 && len(missing) == 0 {
+		return nil
+	}
+	return &ColumnMismatchError{Model: descriptor.Name, Extra: extra, Missing: missing}
+}
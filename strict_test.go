@@ -0,0 +1,57 @@
+package lorm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrictColumns(t *testing.T) {
+	engine := newRowsEngine(t)
+	ctx := context.TODO()
+
+	// lenient by default
+	list, err := Query[*nullModel](engine).Select("id", "age", "1 AS x").Find(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, list, 10)
+
+	_, err = Query[*nullModel](engine).Select("id", "age", "1 AS x").Strict().Find(ctx)
+	var mismatch *ColumnMismatchError
+	if assert.ErrorAs(t, err, &mismatch) {
+		assert.Equal(t, []string{"x"}, mismatch.Extra)
+		assert.Equal(t, []string{"nickname", "score", "extra", "phone"}, mismatch.Missing)
+	}
+	assert.EqualError(t, err, "lorm: columns of nullModel do not match: extra columns x; missing columns nickname, score, extra, phone")
+
+	list, err = Query[*nullModel](engine).Select("id", "age", "extra").Strict("nickname", "score", "phone").Find(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, list, 10)
+
+	_, err = Query[*nullModel](engine).Select("id").Strict("nickname", "score", "extra", "phone").Where("id = ?", 1).Get(ctx)
+	assert.EqualError(t, err, "lorm: columns of nullModel do not match: missing columns age")
+
+	err = Query[*nullModel](engine).Select("id").Strict().Each(ctx, func(*nullModel) error { return nil })
+	assert.ErrorAs(t, err, &mismatch)
+
+	// the columns are checked once per result set and every row fails
+	rows, err := Query[*nullModel](engine).Select("id").Strict().Iter(ctx)
+	assert.NoError(t, err)
+	for i := 0; i < 2 && rows.Next(); i++ {
+		_, err = rows.Scan()
+		assert.ErrorAs(t, err, &mismatch)
+	}
+	assert.NoError(t, rows.Close())
+
+	// enabled for every query of the engine
+	WithStrictColumns()(engine.config)
+	_, err = Query[*nullModel](engine).Select("id", "age").Find(ctx)
+	assert.ErrorAs(t, err, &mismatch)
+	list, err = Query[*nullModel](engine).Find(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, list, 10)
+
+	var raw []*nullModel
+	err = engine.Query(ctx, NewModelsScanner(&raw).Strict("extra"), "SELECT id, nickname, score, phone, age, 2 AS y FROM null_model")
+	assert.EqualError(t, err, "lorm: columns of nullModel do not match: extra columns y")
+}
@@ -2,8 +2,6 @@ package lorm
 
 import (
 	"context"
-	"slices"
-	"time"
 
 	"github.com/samber/lo"
 	"github.com/yvvlee/lorm/builder"
@@ -19,9 +17,23 @@ func Update(engine *Engine) *UpdateStmt {
 type UpdateStmt struct {
 	engine  *Engine
 	builder *builder.UpdateBuilder
+	// model is the model set by SetModel, its hooks are called by Exec
+	model Model
 }
 
 func (s *UpdateStmt) Exec(ctx context.Context) (rowsAffected int64, err error) {
+	models := lo.Compact([]Model{s.model})
+	// SetModel binds field pointers, so changes made by the auditor and BeforeUpdate are still sent,
+	// the updater of a loaded model is replaced by the current user
+	if err = fillAuditFields(ctx, s.engine, models, 0, FlagUpdatedBy); err != nil {
+		return 0, err
+	}
+	if err = callHooks(ctx, models, BeforeUpdateHook.BeforeUpdate); err != nil {
+		return 0, err
+	}
+	if err = validateModels(models); err != nil {
+		return 0, err
+	}
 	query, args, err := s.builder.ToSql()
 	if err != nil {
 		return 0, err
@@ -30,7 +42,11 @@ func (s *UpdateStmt) Exec(ctx context.Context) (rowsAffected int64, err error) {
 	if err != nil {
 		return 0, err
 	}
-	return result.RowsAffected()
+	rowsAffected, err = result.RowsAffected()
+	if err != nil {
+		return
+	}
+	return rowsAffected, callHooks(ctx, models, AfterUpdateHook.AfterUpdate)
 }
 
 func (s *UpdateStmt) Table(table string) *UpdateStmt {
@@ -56,29 +72,28 @@ func (s *UpdateStmt) Set(column string, value any) *UpdateStmt {
 	return s
 }
 
+// SetModel sets all fields of model and uses its primary keys as the WHERE clause,
+// model is validated and its BeforeUpdate and AfterUpdate hooks are called by Exec
 func (s *UpdateStmt) SetModel(model Model) *UpdateStmt {
+	s.model = model
 	escaper := s.engine.Escaper()
 	if t, ok := model.(Table); ok {
 		s.builder.Table(escaper.Escape(t.TableName()))
 	}
 	fieldMap := model.LormFieldMap()
 	descriptor := model.LormModelDescriptor()
-	primaryKeys := descriptor.FlagFields(FlagPrimaryKey)
-	if len(primaryKeys) > 0 {
-		s.builder.Where(lo.PickByKeys(fieldMap, primaryKeys))
+	if eq := s.engine.primaryKeyEq(model); len(eq) > 0 {
+		s.builder.Where(eq)
 	}
-	updatedFields := descriptor.FlagFields(FlagUpdated)
-	jsonFields := descriptor.FlagFields(FlagJson)
-	now := time.Now()
-	dataMap := lo.MapEntries(fieldMap, func(key string, value any) (string, any) {
-		if slices.Contains(updatedFields, key) {
-			fillCurrentTime(value, now)
+	now := s.engine.now()
+	dataMap := make(map[string]any, len(descriptor.Fields))
+	for _, field := range descriptor.Fields {
+		value := fieldMap[field.DBField]
+		if field.Flag.HasFlag(FlagUpdated) {
+			fillFieldTime(field, value, now)
 		}
-		if slices.Contains(jsonFields, key) {
-			value = NewJSONFieldWrapper(value)
-		}
-		return escaper.Escape(key), value
-	})
+		dataMap[escaper.Escape(field.DBField)] = s.engine.wrapField(field, value)
+	}
 	s.builder.SetMap(dataMap)
 	return s
 }
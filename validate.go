@@ -0,0 +1,194 @@
+package lorm
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Rule is a validation rule declared in the field tag, eg: `lorm:"email,not_null,size:255"` or `validate:"size:255"`
+type Rule struct {
+	Name  string
+	Param string `json:",omitempty"`
+}
+
+func (r *Rule) String() string {
+	if r.Param == "" {
+		return r.Name
+	}
+	return r.Name + ":" + r.Param
+}
+
+// Validator reports whether value satisfies a rule, value is the field value and param is the rule parameter
+type Validator func(value reflect.Value, param string) bool
+
+var (
+	validatorsMu sync.RWMutex
+	// validators stores the validation rules by name, see RegisterValidator
+	validators = map[string]Validator{
+		"not_null": validateNotNull,
+		"required": validateRequired,
+		"size":     validateSize,
+		"min":      validateMin,
+		"max":      validateMax,
+		"regexp":   validateRegexp,
+	}
+)
+
+// RegisterValidator registers a custom validation rule by name or replaces a built-in one, it is safe to call while writing,
+// lormgen only recognizes the built-in rules in the lorm tag, custom rules must be declared in the validate tag
+func RegisterValidator(name string, validator Validator) {
+	validatorsMu.Lock()
+	defer validatorsMu.Unlock()
+	validators[name] = validator
+}
+
+// LookupValidator returns the validation rule registered by name
+func LookupValidator(name string) (Validator, bool) {
+	validatorsMu.RLock()
+	defer validatorsMu.RUnlock()
+	validator, ok := validators[name]
+	return validator, ok
+}
+
+// ValidationError is returned by writes when models violate the rules declared in their tags
+type ValidationError struct {
+	Errors []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+	return "lorm: validation failed: " + strings.Join(messages, "; ")
+}
+
+// FieldError describes a field which failed a validation rule
+type FieldError struct {
+	Model string
+	Field string
+	Rule  *Rule
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s.%s: %s", e.Model, e.Field, e.Rule)
+}
+
+// Validate checks model against the rules of its fields, it returns a *ValidationError listing every failed rule
+func Validate(model Model) error {
+	return validateModels([]Model{model})
+}
+
+func validateModels[T Model](models []T) error {
+	var errs []*FieldError
+	for _, model := range models {
+		descriptor := model.LormModelDescriptor()
+		var fieldMap map[string]any
+		for _, field := range descriptor.Fields {
+			if len(field.Rules) == 0 {
+				continue
+			}
+			if fieldMap == nil {
+				fieldMap = model.LormFieldMap()
+			}
+			ptr, ok := fieldMap[field.DBField]
+			if !ok {
+				return fmt.Errorf("lorm: %s has no field %s", descriptor.Name, field.DBField)
+			}
+			value := reflect.ValueOf(ptr).Elem()
+			for _, rule := range field.Rules {
+				validator, ok := LookupValidator(rule.Name)
+				if !ok {
+					return fmt.Errorf("lorm: unknown validation rule %q on %s.%s", rule.Name, descriptor.Name, field.DBField)
+				}
+				if !validator(value, rule.Param) {
+					errs = append(errs, &FieldError{Model: descriptor.Name, Field: field.DBField, Rule: rule})
+				}
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func validateNotNull(value reflect.Value, _ string) bool {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+		return !value.IsNil()
+	}
+	return true
+}
+
+func validateRequired(value reflect.Value, _ string) bool {
+	return !value.IsZero()
+}
+
+func validateSize(value reflect.Value, param string) bool {
+	size, err := strconv.Atoi(param)
+	if err != nil {
+		return false
+	}
+	value = reflect.Indirect(value)
+	switch value.Kind() {
+	case reflect.String:
+		return utf8.RuneCountInString(value.String()) <= size
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return value.Len() <= size
+	}
+	return true
+}
+
+func validateMin(value reflect.Value, param string) bool {
+	return compareNumber(value, param, func(v, limit float64) bool {
+		return v >= limit
+	})
+}
+
+func validateMax(value reflect.Value, param string) bool {
+	return compareNumber(value, param, func(v, limit float64) bool {
+		return v <= limit
+	})
+}
+
+// compareNumber compares a numeric value with param, nil pointers and non-numeric values always pass
+func compareNumber(value reflect.Value, param string, compare func(v, limit float64) bool) bool {
+	limit, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return false
+	}
+	value = reflect.Indirect(value)
+	switch {
+	case value.CanInt():
+		return compare(float64(value.Int()), limit)
+	case value.CanUint():
+		return compare(float64(value.Uint()), limit)
+	case value.CanFloat():
+		return compare(value.Float(), limit)
+	}
+	return true
+}
+
+var regexpCache sync.Map
+
+func validateRegexp(value reflect.Value, param string) bool {
+	value = reflect.Indirect(value)
+	if value.Kind() != reflect.String {
+		return true
+	}
+	re, ok := regexpCache.Load(param)
+	if !ok {
+		compiled, err := regexp.Compile(param)
+		if err != nil {
+			return false
+		}
+		re, _ = regexpCache.LoadOrStore(param, compiled)
+	}
+	return re.(*regexp.Regexp).MatchString(value.String())
+}
@@ -0,0 +1,70 @@
+package lorm
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	nick := "bob"
+	assert.NoError(t, Validate(&validateModel{Email: "bob@example.com", Nick: &nick, Age: 20}))
+
+	err := Validate(&validateModel{Email: "Bob@Example.com.cn", Age: 200})
+	var validationErr *ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, []string{"email: size:16", "email: regexp:^[a-z]+@[a-z]+\\.com$", "nick: not_null", "age: max:150"},
+		failedRules(validationErr.Errors))
+	assert.Contains(t, err.Error(), "lorm: validation failed: validateModel.email: size:16")
+
+	long := "bobby"
+	err = Validate(&validateModel{Nick: &long, Age: -1})
+	assert.Equal(t, []string{"email: required", "email: regexp:^[a-z]+@[a-z]+\\.com$", "nick: size:3", "age: min:0"},
+		failedRules(err.(*ValidationError).Errors))
+}
+
+func TestValidateBeforeInsert(t *testing.T) {
+	engine := newSQLiteEngine(t, "CREATE TABLE validate_model (id INTEGER PRIMARY KEY, email TEXT, nick TEXT, age INTEGER)")
+	ctx := context.TODO()
+	_, err := InsertAll(ctx, engine, []*validateModel{{ID: 1, Email: "a@b.com"}, {ID: 2, Email: "x"}})
+	assert.ErrorAs(t, err, new(*ValidationError))
+	exist, err := Query[*validateModel](engine).Exist(ctx)
+	assert.NoError(t, err)
+	assert.False(t, exist)
+
+	_, err = Update(engine).SetModel(&validateModel{ID: 1, Email: "a@b.com", Age: 151}).Exec(ctx)
+	assert.ErrorAs(t, err, new(*ValidationError))
+}
+
+// brokenValidateModel declares a field missing from its field map
+type brokenValidateModel struct {
+	validateModel
+}
+
+func (m *brokenValidateModel) LormFieldMap() map[string]any {
+	return map[string]any{"id": &m.ID}
+}
+
+func TestRegisterValidator(t *testing.T) {
+	RegisterValidator("even", func(value reflect.Value, _ string) bool {
+		return value.Int()%2 == 0
+	})
+	validator, ok := LookupValidator("even")
+	assert.True(t, ok)
+	assert.True(t, validator(reflect.ValueOf(2), ""))
+	_, ok = LookupValidator("odd")
+	assert.False(t, ok)
+
+	err := Validate(&brokenValidateModel{})
+	assert.EqualError(t, err, "lorm: validateModel has no field email")
+}
+
+func failedRules(errs []*FieldError) []string {
+	return lo.Map(errs, func(err *FieldError, _ int) string {
+		return err.Field + ": " + err.Rule.String()
+	})
+}